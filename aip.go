@@ -0,0 +1,239 @@
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// PageTokenRequester : google aip-158 list request (PageTokenRequest or the list request of the api)
+type PageTokenRequester interface {
+	GetPageSize() int32
+	GetPageToken() string
+	GetFilter() string
+	GetOrderBy() string
+}
+
+// PageTokenOption : google aip-158 list request => cursor mode paging option ,
+// the order_by (google aip-132) is the cursor columns ,
+// the page_token is rejected if the filter or order_by changed between calls ,
+// the page_token requires a CursorSealer (DefaultCursorSealer or WithCursorSealer) , the plain token can be forged
+//
+// the next_page_token requires the peek mode or the total records (PagingResultCollection.TotalRecords) ,
+// use PageTokenOptionCollection for the peek mode option collection
+//
+// example :
+//			pagination.DefaultCursorSealer = pagination.MustNewHMACCursorSealer(&pagination.CursorKey{ID: "2021-02", Secret: secret})
+//
+//			option, err := pagination.PageTokenOption(req)
+//			collection, err := pagination.GetOptionCollection(option, &User{})
+//			// query users with the collection && the filter
+//			result, err := pagination.SetPagingResult(collection, &pagination.PagingResultCollection{...})
+//			response, err := pagination.NewPageTokenResponse(req, result)
+func PageTokenOption(req PageTokenRequester) (*PagingOption, error) {
+	return defaultPaginator().PageTokenOption(req)
+}
+
+// PageTokenOptionCollection : google aip-158 list request => the peek mode option collection ,
+// the total_size is optional in aip-158 , so the next page is detected by the extra record (Limit is PageSize + 1)
+//
+// example :
+//			collection, err := pagination.PageTokenOptionCollection(req, &User{})
+//			// query users with the collection && the filter (without count)
+//			result, err := pagination.SetPagingResult(collection, &pagination.PagingResultCollection{ResultSlice: &users})
+//			response, err := pagination.NewPageTokenResponse(req, result)
+func PageTokenOptionCollection(req PageTokenRequester, models ...interface{}) (*PagingOptionCollection, error) {
+	return defaultPaginator().PageTokenOptionCollection(req, models...)
+}
+
+// NextPageToken : the next_page_token of the paging result (empty : the last page)
+func NextPageToken(req PageTokenRequester, pagingResult *PagingResult) (string, error) {
+	return defaultPaginator().NextPageToken(req, pagingResult)
+}
+
+// NewPageTokenResponse : the next_page_token && total_size of the paging result
+func NewPageTokenResponse(req PageTokenRequester, pagingResult *PagingResult) (*PageTokenResponse, error) {
+	return defaultPaginator().NewPageTokenResponse(req, pagingResult)
+}
+
+// PageTokenOption : google aip-158 list request => cursor mode paging option of the paginator ,
+// the invalid request returns ValidationErrors (the field is page_size, page_token or order_by)
+func (paginator *Paginator) PageTokenOption(req PageTokenRequester) (*PagingOption, error) {
+
+	if req == nil {
+		return nil, fmt.Errorf("PageTokenRequester cannot be a nil pointer")
+	}
+	if err := paginator.checkPageTokenSealer(); err != nil {
+		return nil, err
+	}
+
+	var errs ValidationErrors
+	invalid := func(field string, value interface{}, reason string) {
+		errs = append(errs, &ValidationError{Field: field, Value: fmt.Sprint(value), Reason: reason})
+	}
+	if req.GetPageSize() < 0 {
+		invalid("page_size", req.GetPageSize(), "must be greater than or equal to 0")
+	}
+	orders, err := ParseOrderBy(req.GetOrderBy())
+	if err != nil {
+		invalid("order_by", req.GetOrderBy(), err.Error())
+	}
+
+	// page token : bound to the filter && order_by
+	if token := req.GetPageToken(); token != "" && err == nil {
+		cursor, err := paginator.DecodeCursor(token)
+		if err != nil {
+			invalid("page_token", token, err.Error())
+		} else if cursor.Binding != pageTokenBinding(req.GetFilter(), orders) {
+			invalid("page_token", token, "filter or order_by changed since the previous page")
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	pagingOption := paginator.DefaultPagingOption()
+	pagingOption.PagingMode = PagingModeCursor
+	pagingOption.PageSize = proto.Int64(paginator.getPageSize(int64(req.GetPageSize())))
+	pagingOption.CursorColumns = orders
+	pagingOption.Cursor = req.GetPageToken()
+
+	// init && validate
+	if err := paginator.InitPagingOption(pagingOption); err != nil {
+		return nil, err
+	}
+	return pagingOption, nil
+}
+
+// PageTokenOptionCollection : google aip-158 list request => the peek mode option collection of the paginator
+func (paginator *Paginator) PageTokenOptionCollection(req PageTokenRequester, models ...interface{}) (*PagingOptionCollection, error) {
+
+	pagingOption, err := paginator.PageTokenOption(req)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := paginator.GetOptionCollection(pagingOption, models...)
+	if err != nil {
+		return nil, err
+	}
+
+	// peek mode : the next_page_token without the total records
+	collection.Peek = true
+	collection.Limit = collection.Option.GetPageSize() + 1
+	return collection, nil
+}
+
+// NextPageToken : the next_page_token of the paging result (the next cursor bound to the filter && order_by)
+func (paginator *Paginator) NextPageToken(req PageTokenRequester, pagingResult *PagingResult) (string, error) {
+
+	if req == nil || pagingResult == nil {
+		return "", fmt.Errorf("PageTokenRequester && PagingResult cannot be a nil pointer")
+	}
+	if err := paginator.checkPageTokenSealer(); err != nil {
+		return "", err
+	}
+	if pagingResult.NextCursor == "" {
+		return "", nil
+	}
+
+	orders, err := ParseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return "", err
+	}
+	cursor, err := paginator.DecodeCursor(pagingResult.NextCursor)
+	if err != nil {
+		return "", err
+	}
+	cursor.Binding = pageTokenBinding(req.GetFilter(), orders)
+	return paginator.EncodeCursor(cursor)
+}
+
+// NewPageTokenResponse : the next_page_token && total_size of the paging result ,
+// the total_size is 0 with the unknown total records (peek mode) , and clamp to math.MaxInt32 (int32 of aip-158)
+func (paginator *Paginator) NewPageTokenResponse(req PageTokenRequester, pagingResult *PagingResult) (*PageTokenResponse, error) {
+
+	nextPageToken, err := paginator.NextPageToken(req, pagingResult)
+	if err != nil {
+		return nil, err
+	}
+
+	totalSize := pagingResult.TotalSize
+	if totalSize > math.MaxInt32 {
+		totalSize = math.MaxInt32
+	}
+	return &PageTokenResponse{
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(totalSize),
+	}, nil
+}
+
+// ParseOrderBy : google aip-132 order by => order by , the fields are separated by comma ,
+// the field is asc without the desc suffix
+//
+// example : created_at desc, id => created_at desc , id asc
+func ParseOrderBy(orderBy string) ([]*PagingOrder, error) {
+
+	orders := make([]*PagingOrder, 0)
+	for _, item := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(item)
+		if len(fields) == 0 {
+			if strings.TrimSpace(orderBy) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("order_by field cannot be empty")
+		}
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("order_by field(%s) invalid", strings.TrimSpace(item))
+		}
+
+		order := &PagingOrder{Column: fields[0], Direction: "asc"}
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				order.Direction = "desc"
+			default:
+				return nil, fmt.Errorf("order_by field(%s) direction(%s) must be asc or desc", fields[0], fields[1])
+			}
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// FormatOrderBy : order by => google aip-132 order by (example : created_at desc, id)
+func FormatOrderBy(orders []*PagingOrder) string {
+
+	items := make([]string, 0, len(orders))
+	for _, order := range orders {
+		if order == nil {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(order.Direction), "desc") {
+			items = append(items, order.Column+" desc")
+		} else {
+			items = append(items, order.Column)
+		}
+	}
+	return strings.Join(items, ", ")
+}
+
+// checkPageTokenSealer the page token is not issued or accepted without the cursor sealer ,
+// the binding of the plain token can be recomputed by the client
+func (paginator *Paginator) checkPageTokenSealer() error {
+	if paginator.cursorSealer == nil {
+		return fmt.Errorf("page token requires a CursorSealer : set DefaultCursorSealer or WithCursorSealer")
+	}
+	return nil
+}
+
+// pageTokenBinding the hash of the filter && the normalized order by
+func pageTokenBinding(filter string, orders []*PagingOrder) string {
+
+	hash := sha256.Sum256([]byte(strings.TrimSpace(filter) + "\n" + FormatOrderBy(orders)))
+	return base64.RawURLEncoding.EncodeToString(hash[:16])
+}
@@ -0,0 +1,121 @@
+package pagination
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// aip-158 : page_size, page_token, next_page_token && the bound filter, order_by
+func TestPageToken(t *testing.T) {
+	t.Parallel()
+
+	paginator := NewPaginator(WithCursorSealer(MustNewHMACCursorSealer(&CursorKey{ID: "k1", Secret: []byte("page token secret : 32 bytes ...")})))
+
+	// the peek mode option collection , without the total records
+	list := func(req *PageTokenRequest) (string, *PageTokenResponse, error) {
+		collection, err := paginator.PageTokenOptionCollection(req, &relayModel{})
+		if err != nil {
+			return "", nil, err
+		}
+		records := queryRelayModels(collection)
+		result, err := paginator.SetPagingResult(collection, &PagingResultCollection{ResultSlice: &records})
+		if err != nil {
+			return "", nil, err
+		}
+		response, err := paginator.NewPageTokenResponse(req, result)
+		if err != nil {
+			return "", nil, err
+		}
+		var ids []int64
+		for _, record := range records {
+			ids = append(ids, record.Id)
+		}
+		return fmt.Sprint(ids), response, nil
+	}
+
+	// first page : 1 2 3 4
+	ids, first, err := list(&PageTokenRequest{PageSize: 4, Filter: `name = "a"`, OrderBy: "id"})
+	if err != nil || ids != "[1 2 3 4]" || first.NextPageToken == "" {
+		t.Errorf("\n testing : first page error : %v %s %+v \n", err, ids, first)
+		return
+	}
+
+	// next page : the same filter && order_by (the equivalent order_by)
+	ids, second, err := list(&PageTokenRequest{PageSize: 4, PageToken: first.NextPageToken, Filter: `name = "a"`, OrderBy: " id  ASC"})
+	if err != nil || ids != "[5 6 7 8]" || second.NextPageToken == "" {
+		t.Errorf("\n testing : next page error : %v %s %+v \n", err, ids, second)
+		return
+	}
+
+	// last page : the empty next_page_token
+	ids, last, err := list(&PageTokenRequest{PageSize: 4, PageToken: second.NextPageToken, Filter: `name = "a"`, OrderBy: "id"})
+	if err != nil || ids != "[9 10]" || last.NextPageToken != "" {
+		t.Errorf("\n testing : last page error : %v %s %+v \n", err, ids, last)
+		return
+	}
+
+	// the changed filter && order_by are rejected
+	for _, req := range []*PageTokenRequest{
+		{PageSize: 4, PageToken: first.NextPageToken, Filter: `name = "b"`, OrderBy: "id"},
+		{PageSize: 4, PageToken: first.NextPageToken, Filter: `name = "a"`, OrderBy: "id desc"},
+		{PageSize: 4, PageToken: first.NextPageToken, Filter: `name = "a"`},
+	} {
+		_, _, err = list(req)
+		var validationErrors ValidationErrors
+		if !errors.As(err, &validationErrors) || validationErrors[0].Field != "page_token" {
+			t.Errorf("\n testing : changed request error : %v %+v \n", err, req)
+		}
+	}
+
+	// the next_page_token is bound to the filter && order_by
+	cursor, err := paginator.DecodeCursor(first.NextPageToken)
+	if err != nil || cursor.Binding == "" {
+		t.Errorf("\n testing : page token binding error : %v %+v \n", err, cursor)
+	}
+
+	// the plain token is not issued or accepted
+	plainPaginator := NewPaginator(WithPeekMode(true), WithCursorSealer(nil))
+	if _, err = plainPaginator.PageTokenOption(&PageTokenRequest{PageSize: 4, OrderBy: "id"}); err == nil {
+		t.Errorf("\n testing : PageTokenOption without sealer should fail \n")
+	}
+	if _, err = plainPaginator.NextPageToken(&PageTokenRequest{OrderBy: "id"}, &PagingResult{NextCursor: first.NextPageToken}); err == nil {
+		t.Errorf("\n testing : NextPageToken without sealer should fail \n")
+	}
+
+	// the total_size over int32
+	response, err := paginator.NewPageTokenResponse(&PageTokenRequest{}, &PagingResult{TotalSize: math.MaxInt32 + 1})
+	if err != nil || response.TotalSize != math.MaxInt32 {
+		t.Errorf("\n testing : NewPageTokenResponse total_size error : %v %+v \n", err, response)
+	}
+
+	// invalid request
+	for _, req := range []*PageTokenRequest{
+		{PageSize: -1},
+		{OrderBy: "id sideways"},
+		{OrderBy: "id,,name"},
+		{PageToken: "invalid"},
+	} {
+		_, err = paginator.PageTokenOption(req)
+		var validationErrors ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			t.Errorf("\n testing : invalid request error : %v %+v \n", err, req)
+		}
+	}
+}
+
+// aip-132 : order by
+func TestParseOrderBy(t *testing.T) {
+	t.Parallel()
+
+	orders, err := ParseOrderBy("created_at desc, id, name ASC")
+	if err != nil || FormatOrderBy(orders) != "created_at desc, id, name" {
+		t.Errorf("\n testing : ParseOrderBy error : %v %s \n", err, FormatOrderBy(orders))
+	}
+
+	orders, err = ParseOrderBy(" ")
+	if err != nil || len(orders) != 0 {
+		t.Errorf("\n testing : ParseOrderBy empty error : %v %+v \n", err, orders)
+	}
+}
@@ -0,0 +1,215 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultCountStrategy : the total records strategy of PagingOptionCollection.Count (default : nil, exact count)
+//
+// example :
+//			pagination.DefaultCountStrategy = pagination.NewCachedCount(pagination.NewCappedCount(10000), time.Minute)
+var DefaultCountStrategy CountStrategy
+
+// CountStrategy : count the total records with the count queries of the driver
+type CountStrategy interface {
+	// Count the total records
+	Count(ctx context.Context, query *CountQuery) (*PagingCount, error)
+}
+
+// CountQuery : the count queries of the driver (paginationgorm, paginationsql ...)
+type CountQuery struct {
+	Key      string                                              // the query filter (example : sql && args) , the key of the cached count
+	KeyFunc  func() string                                       // the lazy Key (optional, called by the cached count only when the Key is empty)
+	Count    func(ctx context.Context) (int64, error)            // exact count
+	CountMax func(ctx context.Context, max int64) (int64, error) // count at most max records (optional, the capped count use Count without it)
+	Estimate func(ctx context.Context) (int64, error)            // planner estimated count (optional, example : postgres EXPLAIN, mysql information_schema)
+}
+
+// key the key of the cached count : Key , or KeyFunc
+func (query *CountQuery) key() string {
+
+	if query.Key == "" && query.KeyFunc != nil {
+		return query.KeyFunc()
+	}
+	return query.Key
+}
+
+// PagingCount : the total records of the count strategy
+type PagingCount struct {
+	Total       int64 // total records
+	Approximate bool  // the total records is approximate (estimated or capped count)
+}
+
+// Count : count the total records with the count strategy of the paginator ,
+// the count is skipped in peek mode (the total records is optional)
+func (collection *PagingOptionCollection) Count(ctx context.Context, query *CountQuery) (*PagingCount, error) {
+
+	if collection.Peek {
+		return &PagingCount{}, nil
+	}
+	if query == nil {
+		return nil, fmt.Errorf("CountQuery cannot be a nil pointer")
+	}
+
+	strategy := collection.getPaginator().countStrategy
+	if strategy == nil {
+		strategy = NewExactCount()
+	}
+	return strategy.Count(ctx, query)
+}
+
+// exactCount exact count
+type exactCount struct{}
+
+// NewExactCount : exact count (CountQuery.Count)
+func NewExactCount() CountStrategy {
+	return &exactCount{}
+}
+
+// Count exact count
+func (strategy *exactCount) Count(ctx context.Context, query *CountQuery) (*PagingCount, error) {
+
+	if query.Count == nil {
+		return nil, fmt.Errorf("exact count need CountQuery.Count")
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &PagingCount{Total: total}, nil
+}
+
+// cappedCount count at most max records
+type cappedCount struct {
+	max int64
+}
+
+// NewCappedCount : count at most max records ,
+// the total records over max is reported as max && approximate (example : "10000+")
+func NewCappedCount(max int64) CountStrategy {
+	return &cappedCount{max: max}
+}
+
+// Count count at most max + 1 records
+func (strategy *cappedCount) Count(ctx context.Context, query *CountQuery) (*PagingCount, error) {
+
+	if strategy.max <= 0 {
+		return NewExactCount().Count(ctx, query)
+	}
+
+	var (
+		total int64
+		err   error
+	)
+	switch {
+	case query.CountMax != nil:
+		total, err = query.CountMax(ctx, strategy.max+1)
+	case query.Count != nil:
+		total, err = query.Count(ctx)
+	default:
+		return nil, fmt.Errorf("capped count need CountQuery.CountMax or CountQuery.Count")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if total > strategy.max {
+		return &PagingCount{Total: strategy.max, Approximate: true}, nil
+	}
+	return &PagingCount{Total: total}, nil
+}
+
+// estimatedCount planner estimated count
+type estimatedCount struct{}
+
+// NewEstimatedCount : planner estimated count (CountQuery.Estimate) , the total records is approximate
+func NewEstimatedCount() CountStrategy {
+	return &estimatedCount{}
+}
+
+// Count planner estimated count
+func (strategy *estimatedCount) Count(ctx context.Context, query *CountQuery) (*PagingCount, error) {
+
+	if query.Estimate == nil {
+		return nil, fmt.Errorf("estimated count need CountQuery.Estimate")
+	}
+
+	total, err := query.Estimate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if total < 0 {
+		total = 0
+	}
+	return &PagingCount{Total: total, Approximate: true}, nil
+}
+
+// cachedCount the count of the strategy cached by CountQuery.Key (or CountQuery.KeyFunc)
+type cachedCount struct {
+	strategy CountStrategy
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*cachedCountEntry
+}
+
+// cachedCountEntry cached count
+type cachedCountEntry struct {
+	count     *PagingCount
+	expiresAt time.Time
+}
+
+// NewCachedCount : cache the count of the strategy by CountQuery.Key (or CountQuery.KeyFunc) for ttl ,
+// the query without the key is not cached
+func NewCachedCount(strategy CountStrategy, ttl time.Duration) CountStrategy {
+
+	if strategy == nil {
+		strategy = NewExactCount()
+	}
+	return &cachedCount{strategy: strategy, ttl: ttl, entries: make(map[string]*cachedCountEntry)}
+}
+
+// Count the cached count , or count with the strategy
+func (strategy *cachedCount) Count(ctx context.Context, query *CountQuery) (*PagingCount, error) {
+
+	if strategy.ttl <= 0 {
+		return strategy.strategy.Count(ctx, query)
+	}
+	key := query.key()
+	if key == "" {
+		return strategy.strategy.Count(ctx, query)
+	}
+
+	now := time.Now()
+	strategy.mu.Lock()
+	entry, ok := strategy.entries[key]
+	strategy.mu.Unlock()
+
+	if ok && now.Before(entry.expiresAt) {
+		return &PagingCount{Total: entry.count.Total, Approximate: entry.count.Approximate}, nil
+	}
+
+	count, err := strategy.strategy.Count(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy.mu.Lock()
+	defer strategy.mu.Unlock()
+
+	// drop the expired entries
+	for key, entry := range strategy.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(strategy.entries, key)
+		}
+	}
+	strategy.entries[key] = &cachedCountEntry{
+		count:     &PagingCount{Total: count.Total, Approximate: count.Approximate},
+		expiresAt: now.Add(strategy.ttl),
+	}
+	return count, nil
+}
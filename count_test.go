@@ -0,0 +1,121 @@
+package pagination
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// exact, capped, estimated && cached count
+func TestCountStrategy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	calls := 0
+	query := &CountQuery{
+		Key: "SELECT * FROM tb_user",
+		Count: func(ctx context.Context) (int64, error) {
+			calls++
+			return 12345, nil
+		},
+		Estimate: func(ctx context.Context) (int64, error) {
+			return 12000, nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		strategy CountStrategy
+		count    PagingCount
+	}{
+		{name: "exact", strategy: NewExactCount(), count: PagingCount{Total: 12345}},
+		{name: "capped", strategy: NewCappedCount(10000), count: PagingCount{Total: 10000, Approximate: true}},
+		{name: "capped under max", strategy: NewCappedCount(20000), count: PagingCount{Total: 12345}},
+		{name: "estimated", strategy: NewEstimatedCount(), count: PagingCount{Total: 12000, Approximate: true}},
+	}
+	for _, test := range tests {
+		count, err := test.strategy.Count(ctx, query)
+		if err != nil || *count != test.count {
+			t.Errorf("\n testing : %s count error : %v %+v \n", test.name, err, count)
+		}
+	}
+
+	// cached by the query key
+	calls = 0
+	cached := NewCachedCount(NewExactCount(), time.Minute)
+	for i := 0; i < 3; i++ {
+		if count, err := cached.Count(ctx, query); err != nil || count.Total != 12345 {
+			t.Errorf("\n testing : cached count error : %v %+v \n", err, count)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("\n testing : cached count error : %d calls \n", calls)
+	}
+
+	// the lazy key : only the cached count build the key
+	keys := 0
+	lazyQuery := &CountQuery{
+		KeyFunc: func() string {
+			keys++
+			return "SELECT * FROM tb_user WHERE status = 1"
+		},
+		Count: query.Count,
+	}
+	calls = 0
+	for i := 0; i < 2; i++ {
+		if _, err := NewExactCount().Count(ctx, lazyQuery); err != nil {
+			t.Errorf("\n testing : exact count error : %v \n", err)
+		}
+		if _, err := cached.Count(ctx, lazyQuery); err != nil {
+			t.Errorf("\n testing : cached count error : %v \n", err)
+		}
+	}
+	if keys != 2 || calls != 3 {
+		t.Errorf("\n testing : lazy key error : %d keys %d calls \n", keys, calls)
+	}
+
+	// estimated count without the driver hook
+	if _, err := NewEstimatedCount().Count(ctx, &CountQuery{}); err == nil {
+		t.Errorf("\n testing : estimated count should fail without CountQuery.Estimate \n")
+	}
+}
+
+// the approximate total records of the paging result
+func TestApproximateResult(t *testing.T) {
+	t.Parallel()
+
+	type Model struct {
+		Id int64
+	}
+
+	paginator := NewPaginator(WithCountStrategy(NewCappedCount(4)))
+
+	option := DefaultPagingOption()
+	option.PageSize = proto.Int64(2)
+	option.GotoPageNumber = proto.Int64(2)
+
+	collection, err := paginator.GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	count, err := collection.Count(context.Background(), &CountQuery{
+		Count: func(ctx context.Context) (int64, error) { return 100, nil },
+	})
+	if err != nil {
+		t.Errorf("\n testing : Count error : %v \n", err)
+		return
+	}
+
+	result, err := SetPagingResult(collection, &PagingResultCollection{
+		TotalRecords:     count.Total,
+		TotalApproximate: count.Approximate,
+		ResultSlice:      []Model{{Id: 3}, {Id: 4}},
+	})
+	if err != nil || !result.TotalSizeApproximate || result.TotalSize != 4 || !result.HasNextPage {
+		t.Errorf("\n testing : SetPagingResult error : %v %+v \n", err, result)
+	}
+}
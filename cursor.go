@@ -0,0 +1,421 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// cursor token version
+const (
+	CursorVersion1 int64 = 1 // cursor token version : 1 , float values
+	CursorVersion2 int64 = 2 // cursor token version : 2 , typed values
+)
+
+// cursor error code
+const (
+	CursorErrorInvalid = "invalid" // cursor error : malformed or tampered token
+	CursorErrorExpired = "expired" // cursor error : expired token
+)
+
+// DefaultCursorExpiration : cursor token expiration (default : 0, never expire)
+var DefaultCursorExpiration time.Duration
+
+// CursorError : invalid or expired cursor token
+type CursorError struct {
+	Code string // error code : invalid or expired
+	Err  error  // error
+}
+
+// Error : error message
+func (e *CursorError) Error() string {
+	return fmt.Sprintf("cursor %s : %v", e.Code, e.Err)
+}
+
+// Unwrap : error
+func (e *CursorError) Unwrap() error {
+	return e.Err
+}
+
+// newCursorError cursor error
+func newCursorError(code string, format string, args ...interface{}) *CursorError {
+	return &CursorError{Code: code, Err: fmt.Errorf(format, args...)}
+}
+
+// PagingCursor : cursor token payload
+// (PagingOption.Cursor , PagingResult.NextCursor , PagingResult.PrevCursor)
+//
+// example :
+//			{"v":1,"c":[{"column":"created_at","direction":"desc"},{"column":"id","direction":"desc"}],"x":[1613577600,7],"p":2}
+//			{"v":2,"c":[{"column":"created_at","direction":"desc"},{"column":"id","direction":"desc"}],"y":["t:2021-02-18T08:00:00Z","i:7"],"p":2}
+type PagingCursor struct {
+	Version  int64                // version
+	Columns  []*PagingOrder       // cursor columns
+	Values   []*PagingCursorValue // cursor values, one value per cursor columns
+	Page     int64                // page number of the cursor page
+	Backward bool                 // preceding page : the records before the cursor
+	ExpireAt int64                // expire at : unix timestamp (default : 0, never expire)
+	Binding  string               // the hash of the request parameters bound to the cursor (example : aip-158 filter && order_by)
+}
+
+// pagingCursorJSON cursor token payload json
+type pagingCursorJSON struct {
+	Version     int64          `json:"v"`           // version
+	Columns     []*PagingOrder `json:"c"`           // cursor columns
+	FloatValues []float64      `json:"x,omitempty"` // version 1 : float values
+	TypedValues []string       `json:"y,omitempty"` // version 2 : typed values (example : i:7)
+	Page        int64          `json:"p,omitempty"` // page number of the cursor page
+	Backward    bool           `json:"b,omitempty"` // preceding page
+	ExpireAt    int64          `json:"e,omitempty"` // expire at
+	Binding     string         `json:"h,omitempty"` // the hash of the bound request parameters
+}
+
+// MarshalJSON : cursor token payload json
+func (cursor *PagingCursor) MarshalJSON() ([]byte, error) {
+
+	payload := &pagingCursorJSON{
+		Version:  cursor.Version,
+		Columns:  cursor.Columns,
+		Page:     cursor.Page,
+		Backward: cursor.Backward,
+		ExpireAt: cursor.ExpireAt,
+		Binding:  cursor.Binding,
+	}
+
+	for _, value := range cursor.Values {
+		// version 1 : float values
+		if cursor.Version == CursorVersion1 {
+			floatValue, ok := value.Float()
+			if !ok {
+				return nil, fmt.Errorf("cursor version(%d) value(%s) isnot numeric", cursor.Version, formatCursorValue(value))
+			}
+			payload.FloatValues = append(payload.FloatValues, floatValue)
+			continue
+		}
+		payload.TypedValues = append(payload.TypedValues, formatCursorValue(value))
+	}
+	return json.Marshal(payload)
+}
+
+// UnmarshalJSON : cursor token payload json
+func (cursor *PagingCursor) UnmarshalJSON(data []byte) error {
+
+	payload := new(pagingCursorJSON)
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	cursor.Version = payload.Version
+	cursor.Columns = payload.Columns
+	cursor.Values = nil
+	cursor.Page = payload.Page
+	cursor.Backward = payload.Backward
+	cursor.ExpireAt = payload.ExpireAt
+	cursor.Binding = payload.Binding
+
+	// version 1 : float values
+	for _, floatValue := range payload.FloatValues {
+		cursor.Values = append(cursor.Values, &PagingCursorValue{Value: &PagingCursorValue_DoubleValue{DoubleValue: floatValue}})
+	}
+
+	// version 2 : typed values
+	for _, text := range payload.TypedValues {
+		value, err := parseCursorValue(text)
+		if err != nil {
+			return err
+		}
+		cursor.Values = append(cursor.Values, value)
+	}
+	return nil
+}
+
+// DefaultCursorEncodeHandler : encode cursor token ,
+// base64url(json(PagingCursor)) , or base64url(DefaultCursorSealer.Seal(json(PagingCursor)))
+var DefaultCursorEncodeHandler = func(cursor *PagingCursor) (string, error) {
+	return encodeCursorToken(cursor, DefaultCursorSealer)
+}
+
+// encodeCursorToken base64url(json(PagingCursor)) , or base64url(sealer.Seal(json(PagingCursor)))
+func encodeCursorToken(cursor *PagingCursor, sealer CursorSealer) (string, error) {
+
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("cursor encode fail : %v", err)
+	}
+
+	// seal
+	if sealer != nil {
+		if payload, err = sealer.Seal(payload); err != nil {
+			return "", fmt.Errorf("cursor seal fail : %v", err)
+		}
+	}
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+// DefaultCursorDecodeHandler : decode cursor token ,
+// json(base64url(token)) , or json(DefaultCursorSealer.Open(base64url(token)))
+var DefaultCursorDecodeHandler = func(token string) (*PagingCursor, error) {
+	return decodeCursorToken(token, DefaultCursorSealer)
+}
+
+// decodeCursorToken json(base64url(token)) , or json(sealer.Open(base64url(token)))
+func decodeCursorToken(token string, sealer CursorSealer) (*PagingCursor, error) {
+
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, newCursorError(CursorErrorInvalid, "cursor decode fail : %v", err)
+	}
+
+	// open
+	if sealer != nil {
+		if payload, err = sealer.Open(payload); err != nil {
+			return nil, newCursorError(CursorErrorInvalid, "cursor open fail : %v", err)
+		}
+	}
+
+	cursor := new(PagingCursor)
+	if err := json.Unmarshal(payload, cursor); err != nil {
+		return nil, newCursorError(CursorErrorInvalid, "cursor decode fail : %v", err)
+	}
+	return cursor, nil
+}
+
+// EncodeCursor : encode cursor token
+func EncodeCursor(cursor *PagingCursor) (string, error) {
+	return defaultPaginator().EncodeCursor(cursor)
+}
+
+// EncodeCursor : encode cursor token
+func (paginator *Paginator) EncodeCursor(cursor *PagingCursor) (string, error) {
+
+	if cursor == nil {
+		return "", fmt.Errorf("PagingCursor cannot be a nil pointer")
+	}
+
+	if column, ok := getNullCursorColumn(cursor.Columns, cursor.Values); ok {
+		return "", fmt.Errorf("cursor column(%s) value cannot be null", column)
+	}
+
+	if cursor.Version == 0 {
+		cursor.Version = CursorVersion2
+	}
+	if cursor.ExpireAt == 0 && paginator.cursorExpiration > 0 {
+		cursor.ExpireAt = time.Now().Add(paginator.cursorExpiration).Unix()
+	}
+	return paginator.cursorEncodeHandler(cursor)
+}
+
+// DecodeCursor : decode and check cursor token ,
+// return *CursorError if the token is invalid or expired
+func DecodeCursor(token string) (*PagingCursor, error) {
+	return defaultPaginator().DecodeCursor(token)
+}
+
+// DecodeCursor : decode and check cursor token ,
+// return *CursorError if the token is invalid or expired
+func (paginator *Paginator) DecodeCursor(token string) (*PagingCursor, error) {
+
+	cursor, err := paginator.cursorDecodeHandler(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// version
+	if cursor.Version != CursorVersion1 && cursor.Version != CursorVersion2 {
+		return nil, newCursorError(CursorErrorInvalid, "cursor version(%d) not supported", cursor.Version)
+	}
+
+	// expire
+	if cursor.ExpireAt > 0 && time.Now().Unix() > cursor.ExpireAt {
+		return nil, newCursorError(CursorErrorExpired, "cursor expired at %s", time.Unix(cursor.ExpireAt, 0).Format(time.RFC3339))
+	}
+
+	// columns && values
+	if len(cursor.Columns) == 0 {
+		return nil, newCursorError(CursorErrorInvalid, "cursor columns cannot be empty")
+	}
+	// the cursor without values : the last page of backward
+	if len(cursor.Values) != len(cursor.Columns) && (len(cursor.Values) > 0 || !cursor.Backward) {
+		return nil, newCursorError(CursorErrorInvalid, "cursor values length(%d) not equal to columns length(%d)",
+			len(cursor.Values), len(cursor.Columns))
+	}
+	if column, ok := getNullCursorColumn(cursor.Columns, cursor.Values); ok {
+		return nil, newCursorError(CursorErrorInvalid, "cursor column(%s) value cannot be null", column)
+	}
+	for i := range cursor.Columns {
+		if cursor.Columns[i] == nil {
+			return nil, newCursorError(CursorErrorInvalid, "cursor column cannot be empty")
+		}
+		direction, err := getSortDirection(cursor.Columns[i].Direction, cursor.Columns[i].SortDirection)
+		if err != nil {
+			return nil, newCursorError(CursorErrorInvalid, "cursor column(%s) %v", cursor.Columns[i].Column, err)
+		}
+		if direction == SortDirection_SORT_DIRECTION_UNSPECIFIED {
+			direction = SortDirection_SORT_DIRECTION_DESC
+		}
+		cursor.Columns[i].Column = getOrderColumn(cursor.Columns[i].Column)
+		cursor.Columns[i].Direction, cursor.Columns[i].SortDirection = direction.Direction(), direction
+	}
+
+	// page
+	if cursor.Page < 1 {
+		cursor.Page = 1
+	}
+	return cursor, nil
+}
+
+// getCursorTokenOptionCollection cursor token option collection
+//
+// # example : cursor columns (id desc)
+//
+//		* next_cursor (the last record of current page : id = 101)
+// 			SELECT * FROM tb_goods WHERE id < 101 ORDER BY id DESC LIMIT 10 OFFSET 0
+//
+//		* prev_cursor (the first record of current page : id = 110)
+// 			SELECT * FROM tb_goods WHERE id > 110 ORDER BY id ASC LIMIT 10 OFFSET 0
+func (paginator *Paginator) getCursorTokenOptionCollection(pagingOption *PagingOption, models ...interface{}) (*PagingOptionCollection, error) {
+
+	cursor, err := paginator.DecodeCursor(pagingOption.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// page limits
+	if err := paginator.limits.checkCursorPage(cursor.Page, pagingOption.GetPageSize()); err != nil {
+		return nil, err
+	}
+
+	// cursor => paging option
+	pagingOption.PagingMode = PagingModeCursor
+	pagingOption.CursorColumns = cursor.Columns
+	pagingOption.CursorValues = nil
+	pagingOption.CursorTypedValues = cursor.Values
+	pagingOption.GotoPageNumber = proto.Int64(cursor.Page)
+	if cursor.Backward {
+		pagingOption.CurrentPageNumber = cursor.Page + 1
+	} else {
+		pagingOption.CurrentPageNumber = cursor.Page - 1
+	}
+
+	// check cursor column
+	if err := paginator.cursorColumnCheckHandler(pagingOption, models...); err != nil {
+		return nil, err
+	}
+
+	collection := &PagingOptionCollection{
+		Option:    pagingOption,
+		Limit:     pagingOption.GetPageSize(),
+		Offset:    0,
+		Where:     []*PagingWhere{},
+		Order:     getCursorOrder(cursor.Columns, cursor.Backward),
+		IsReverse: cursor.Backward,
+	}
+
+	// the backward cursor without values : the last page
+	if len(cursor.Values) > 0 {
+		collection.Where = append(collection.Where, getCursorWhere(cursor.Columns, getCursorValues(pagingOption), !cursor.Backward, false))
+	}
+	return collection, nil
+}
+
+// setCursorToken PagingResult.NextCursor && PagingResult.PrevCursor
+func (paginator *Paginator) setCursorToken(optionCollection *PagingOptionCollection, pagingResult *PagingResult, sliceInfo *PagingResultInfo) error {
+
+	// not cursor mode || empty slice
+	if pagingResult.PagingMode != PagingModeCursor || sliceInfo.SliceLen == 0 {
+		return nil
+	}
+
+	cursorColumns := getCursorColumns(optionCollection.Option)
+
+	// start cursor && end cursor
+	if len(sliceInfo.FirstCursorTypedValues) > 0 {
+		token, err := paginator.encodeRowCursor(optionCollection, pagingResult, sliceInfo.FirstCursorTypedValues)
+		if err != nil {
+			return err
+		}
+		pagingResult.StartCursor = token
+	}
+	if len(sliceInfo.CursorTypedValues) > 0 {
+		token, err := paginator.encodeRowCursor(optionCollection, pagingResult, sliceInfo.CursorTypedValues)
+		if err != nil {
+			return err
+		}
+		pagingResult.EndCursor = token
+	}
+
+	// next page
+	if pagingResult.HasNextPage && len(sliceInfo.CursorTypedValues) > 0 {
+		token, err := paginator.EncodeCursor(&PagingCursor{
+			Columns: cursorColumns,
+			Values:  sliceInfo.CursorTypedValues,
+			Page:    pagingResult.CurrentPage + 1,
+		})
+		if err != nil {
+			return err
+		}
+		pagingResult.NextCursor = token
+	}
+
+	// preceding page
+	if pagingResult.HasPreviousPage && len(sliceInfo.FirstCursorTypedValues) > 0 {
+		token, err := paginator.EncodeCursor(&PagingCursor{
+			Columns:  cursorColumns,
+			Values:   sliceInfo.FirstCursorTypedValues,
+			Page:     pagingResult.CurrentPage - 1,
+			Backward: true,
+		})
+		if err != nil {
+			return err
+		}
+		pagingResult.PrevCursor = token
+	}
+	return nil
+}
+
+// getRowCursors the cursor token of every record , the records after the record
+func (paginator *Paginator) getRowCursors(optionCollection *PagingOptionCollection, pagingResult *PagingResult, resultSlice interface{}) ([]string, error) {
+
+	// not cursor mode
+	if optionCollection.Option.PagingMode != PagingModeCursor {
+		return nil, nil
+	}
+
+	sReflectValue := reflect.ValueOf(resultSlice)
+	if sReflectValue.Kind() == reflect.Ptr {
+		sReflectValue = sReflectValue.Elem()
+	}
+	if sReflectValue.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("ResultSlice not a slice")
+	}
+
+	cursorValuesHandler := optionCollection.getCursorValuesHandler()
+
+	tokens := make([]string, 0, sReflectValue.Len())
+	for i := 0; i < sReflectValue.Len(); i++ {
+		cursorValues, err := cursorValuesHandler(optionCollection, sReflectValue.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := paginator.encodeRowCursor(optionCollection, pagingResult, cursorValues)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+// encodeRowCursor the cursor token of the record values , the records after the record
+func (paginator *Paginator) encodeRowCursor(optionCollection *PagingOptionCollection, pagingResult *PagingResult, cursorValues []*PagingCursorValue) (string, error) {
+	return paginator.EncodeCursor(&PagingCursor{
+		Columns: getCursorColumns(optionCollection.Option),
+		Values:  cursorValues,
+		Page:    pagingResult.CurrentPage + 1,
+	})
+}
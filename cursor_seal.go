@@ -0,0 +1,272 @@
+package pagination
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+// DefaultCursorSealer : seal the cursor token payload (default : nil, not sealed)
+//
+// example :
+//			pagination.DefaultCursorSealer = pagination.MustNewHMACCursorSealer(
+//				&pagination.CursorKey{ID: "2021-02", Secret: currentSecret},  // at least 32 bytes
+//				&pagination.CursorKey{ID: "2021-01", Secret: previousSecret},
+//			)
+var DefaultCursorSealer CursorSealer
+
+// CursorSealer : sign or encrypt the cursor token payload
+type CursorSealer interface {
+	// Seal sign or encrypt the payload
+	Seal(payload []byte) ([]byte, error)
+	// Open verify or decrypt the sealed payload
+	Open(sealed []byte) ([]byte, error)
+}
+
+// CursorKey : cursor sealer key
+type CursorKey struct {
+	ID     string // key id , written into the sealed token to pick the key when open
+	Secret []byte // key secret (hmac : at least 32 bytes ; aes-gcm : 16, 24 or 32 bytes)
+}
+
+// hmacMinSecretSize the min secret size of HMAC-SHA256 (the hash size)
+const hmacMinSecretSize = sha256.Size
+
+// newCursorKeyRing check the keys : at least one key , the unique key id (1 ~ 255 bytes) , the secret size
+func newCursorKeyRing(keys []*CursorKey, checkSecret func(secret []byte) error) (cursorKeyRing, error) {
+
+	if len(keys) == 0 {
+		return cursorKeyRing{}, fmt.Errorf("cursor sealer key cannot be empty")
+	}
+
+	ids := make(map[string]bool, len(keys))
+	for i, key := range keys {
+		if key == nil {
+			return cursorKeyRing{}, fmt.Errorf("cursor sealer key[%d] cannot be a nil pointer", i)
+		}
+		if key.ID == "" || len(key.ID) > 255 {
+			return cursorKeyRing{}, fmt.Errorf("cursor sealer key[%d] id length(%d) must be 1 ~ 255", i, len(key.ID))
+		}
+		if ids[key.ID] {
+			return cursorKeyRing{}, fmt.Errorf("cursor sealer key id(%s) duplicated", key.ID)
+		}
+		ids[key.ID] = true
+
+		if err := checkSecret(key.Secret); err != nil {
+			return cursorKeyRing{}, fmt.Errorf("cursor sealer key(%s) %v", key.ID, err)
+		}
+	}
+	return cursorKeyRing{keys: keys}, nil
+}
+
+// cursorKeyRing keys : the first key seal, all keys open
+type cursorKeyRing struct {
+	keys []*CursorKey
+}
+
+// sealKey the key to seal
+func (ring *cursorKeyRing) sealKey() (*CursorKey, error) {
+
+	if len(ring.keys) == 0 || ring.keys[0] == nil {
+		return nil, fmt.Errorf("cursor sealer key cannot be empty")
+	}
+	if len(ring.keys[0].ID) > 255 {
+		return nil, fmt.Errorf("cursor sealer key id too long")
+	}
+	return ring.keys[0], nil
+}
+
+// openKey the key to open by key id
+func (ring *cursorKeyRing) openKey(sealed []byte) (*CursorKey, []byte, error) {
+
+	// key id length + key id
+	if len(sealed) < 1 || len(sealed) < 1+int(sealed[0]) {
+		return nil, nil, fmt.Errorf("sealed cursor too short")
+	}
+	keyID := string(sealed[1 : 1+int(sealed[0])])
+
+	for _, key := range ring.keys {
+		if key != nil && key.ID == keyID {
+			return key, sealed[1+int(sealed[0]):], nil
+		}
+	}
+	return nil, nil, fmt.Errorf("cursor key(%s) not exist", keyID)
+}
+
+// keyIDPrefix key id length + key id
+func keyIDPrefix(key *CursorKey) []byte {
+	return append([]byte{byte(len(key.ID))}, key.ID...)
+}
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+// hmacCursorSealer HMAC-SHA256 signature
+type hmacCursorSealer struct {
+	cursorKeyRing
+}
+
+// NewHMACCursorSealer : HMAC-SHA256 signed cursor token ;
+// the first key sign, all keys verify (key rotation)
+//
+// the key id cannot be empty , the secret is at least 32 bytes
+//
+// sealed : key id length(1 byte) + key id + payload + HMAC-SHA256(key id + payload)
+func NewHMACCursorSealer(keys ...*CursorKey) (CursorSealer, error) {
+
+	ring, err := newCursorKeyRing(keys, func(secret []byte) error {
+		if len(secret) < hmacMinSecretSize {
+			return fmt.Errorf("secret length(%d) must be at least %d bytes", len(secret), hmacMinSecretSize)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hmacCursorSealer{ring}, nil
+}
+
+// MustNewHMACCursorSealer : NewHMACCursorSealer , panic if the keys are invalid
+func MustNewHMACCursorSealer(keys ...*CursorKey) CursorSealer {
+	sealer, err := NewHMACCursorSealer(keys...)
+	if err != nil {
+		panic(err)
+	}
+	return sealer
+}
+
+// Seal sign the payload
+func (sealer *hmacCursorSealer) Seal(payload []byte) ([]byte, error) {
+
+	key, err := sealer.sealKey()
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := append(keyIDPrefix(key), payload...)
+	return append(sealed, hmacSum(key, sealed)...), nil
+}
+
+// Open verify the signature
+func (sealer *hmacCursorSealer) Open(sealed []byte) ([]byte, error) {
+
+	key, body, err := sealer.openKey(sealed)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) < sha256.Size {
+		return nil, fmt.Errorf("sealed cursor too short")
+	}
+
+	signed := sealed[:len(sealed)-sha256.Size]
+	if !hmac.Equal(hmacSum(key, signed), sealed[len(sealed)-sha256.Size:]) {
+		return nil, fmt.Errorf("cursor signature mismatch")
+	}
+	return body[:len(body)-sha256.Size], nil
+}
+
+// hmacSum HMAC-SHA256
+func hmacSum(key *CursorKey, data []byte) []byte {
+	mac := hmac.New(sha256.New, key.Secret)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+//////////////////////////////////////////////////////////////////////////////////////////
+
+// aesGCMCursorSealer AES-GCM encryption
+type aesGCMCursorSealer struct {
+	cursorKeyRing
+}
+
+// NewAESGCMCursorSealer : AES-GCM encrypted cursor token ;
+// the first key encrypt, all keys decrypt (key rotation)
+//
+// the key id cannot be empty , the secret is 16, 24 or 32 bytes (AES-128, AES-192 or AES-256)
+//
+// sealed : key id length(1 byte) + key id + nonce + AES-GCM(payload, additional data : key id)
+func NewAESGCMCursorSealer(keys ...*CursorKey) (CursorSealer, error) {
+
+	ring, err := newCursorKeyRing(keys, func(secret []byte) error {
+		switch len(secret) {
+		case 16, 24, 32:
+			return nil
+		default:
+			return fmt.Errorf("secret length(%d) must be 16, 24 or 32 bytes", len(secret))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &aesGCMCursorSealer{ring}, nil
+}
+
+// MustNewAESGCMCursorSealer : NewAESGCMCursorSealer , panic if the keys are invalid
+func MustNewAESGCMCursorSealer(keys ...*CursorKey) CursorSealer {
+	sealer, err := NewAESGCMCursorSealer(keys...)
+	if err != nil {
+		panic(err)
+	}
+	return sealer
+}
+
+// Seal encrypt the payload
+func (sealer *aesGCMCursorSealer) Seal(payload []byte) ([]byte, error) {
+
+	key, err := sealer.sealKey()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("cursor nonce fail : %v", err)
+	}
+
+	sealed := append(keyIDPrefix(key), nonce...)
+	return aead.Seal(sealed, nonce, payload, keyIDPrefix(key)), nil
+}
+
+// Open decrypt the payload
+func (sealer *aesGCMCursorSealer) Open(sealed []byte) ([]byte, error) {
+
+	key, body, err := sealer.openKey(sealed)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) < aead.NonceSize() {
+		return nil, fmt.Errorf("sealed cursor too short")
+	}
+
+	prefix := sealed[:len(sealed)-len(body)]
+	payload, err := aead.Open(nil, body[:aead.NonceSize()], body[aead.NonceSize():], prefix)
+	if err != nil {
+		return nil, fmt.Errorf("cursor decrypt fail : %v", err)
+	}
+	return payload, nil
+}
+
+// newGCM AES-GCM
+func newGCM(key *CursorKey) (cipher.AEAD, error) {
+
+	block, err := aes.NewCipher(key.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("cursor key(%s) invalid : %v", key.ID, err)
+	}
+	return cipher.NewGCM(block)
+}
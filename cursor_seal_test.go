@@ -0,0 +1,142 @@
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+// HMAC-SHA256 signed cursor token
+func TestHMACCursorSealer(t *testing.T) {
+	oldKey := &CursorKey{ID: "k1", Secret: []byte("previous secret : 32 bytes at least")}
+	newKey := &CursorKey{ID: "k2", Secret: []byte("current secret : 32 bytes at least.")}
+
+	defer func() { DefaultCursorSealer = nil }()
+	DefaultCursorSealer = MustNewHMACCursorSealer(oldKey)
+
+	token, err := EncodeCursor(&PagingCursor{Columns: []*PagingOrder{{Column: "id", Direction: "desc"}}, Values: []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 101}}}})
+	if err != nil {
+		t.Errorf("\n testing : EncodeCursor error : %v \n", err)
+		return
+	}
+
+	// key rotation : the old token still valid
+	DefaultCursorSealer = MustNewHMACCursorSealer(newKey, oldKey)
+	if _, err = DecodeCursor(token); err != nil {
+		t.Errorf("\n testing : DecodeCursor rotated key error : %v \n", err)
+	}
+
+	// tampered token
+	sealed, _ := base64.RawURLEncoding.DecodeString(token)
+	sealed[len(sealed)-sha256.Size-2] ^= 1
+	_, err = DecodeCursor(base64.RawURLEncoding.EncodeToString(sealed))
+
+	var cursorErr *CursorError
+	if !errors.As(err, &cursorErr) || cursorErr.Code != CursorErrorInvalid {
+		t.Errorf("\n testing : DecodeCursor tampered token should fail with CursorError : %v \n", err)
+	}
+
+	// removed key
+	DefaultCursorSealer = MustNewHMACCursorSealer(newKey)
+	if _, err = DecodeCursor(token); !errors.As(err, &cursorErr) {
+		t.Errorf("\n testing : DecodeCursor removed key should fail with CursorError : %v \n", err)
+	}
+}
+
+// AES-GCM encrypted cursor token
+func TestAESGCMCursorSealer(t *testing.T) {
+	key := &CursorKey{ID: "k1", Secret: []byte("0123456789abcdef0123456789abcdef")}
+
+	defer func() { DefaultCursorSealer = nil }()
+	DefaultCursorSealer = MustNewAESGCMCursorSealer(key)
+
+	token, err := EncodeCursor(&PagingCursor{Columns: []*PagingOrder{{Column: "id", Direction: "desc"}}, Values: []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 101}}}})
+	if err != nil {
+		t.Errorf("\n testing : EncodeCursor error : %v \n", err)
+		return
+	}
+
+	got, err := DecodeCursor(token)
+	if err != nil || got.Values[0].GetIntValue() != 101 {
+		t.Errorf("\n testing : DecodeCursor error : %v \n", err)
+		return
+	}
+
+	// plain token
+	DefaultCursorSealer = nil
+	plain, _ := EncodeCursor(&PagingCursor{Columns: []*PagingOrder{{Column: "id", Direction: "desc"}}, Values: []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 101}}}})
+	DefaultCursorSealer = MustNewAESGCMCursorSealer(key)
+
+	var cursorErr *CursorError
+	if _, err = DecodeCursor(plain); !errors.As(err, &cursorErr) || cursorErr.Code != CursorErrorInvalid {
+		t.Errorf("\n testing : DecodeCursor plain token should fail with CursorError : %v \n", err)
+	}
+}
+
+// the invalid sealer keys
+func TestCursorSealerKeys(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	for _, keys := range [][]*CursorKey{
+		nil,
+		{nil},
+		{{Secret: secret}},
+		{{ID: "k1"}},
+		{{ID: "k1", Secret: secret[:16]}},
+		{{ID: "k1", Secret: secret}, {ID: "k1", Secret: secret}},
+	} {
+		if _, err := NewHMACCursorSealer(keys...); err == nil {
+			t.Errorf("\n testing : NewHMACCursorSealer should fail : %+v \n", keys)
+		}
+	}
+	for _, keys := range [][]*CursorKey{
+		{{ID: "k1"}},
+		{{ID: "k1", Secret: secret[:20]}},
+		{{Secret: secret}},
+	} {
+		if _, err := NewAESGCMCursorSealer(keys...); err == nil {
+			t.Errorf("\n testing : NewAESGCMCursorSealer should fail : %+v \n", keys)
+		}
+	}
+
+	if _, err := NewHMACCursorSealer(&CursorKey{ID: "k1", Secret: secret}); err != nil {
+		t.Errorf("\n testing : NewHMACCursorSealer error : %v \n", err)
+	}
+	for _, size := range []int{16, 24, 32} {
+		if _, err := NewAESGCMCursorSealer(&CursorKey{ID: "k1", Secret: secret[:size]}); err != nil {
+			t.Errorf("\n testing : NewAESGCMCursorSealer(%d) error : %v \n", size, err)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("\n testing : MustNewHMACCursorSealer should panic \n")
+		}
+	}()
+	MustNewHMACCursorSealer(&CursorKey{ID: "k1"})
+}
+
+// expired cursor token
+func TestExpiredCursor(t *testing.T) {
+	token, err := EncodeCursor(&PagingCursor{
+		Columns:  []*PagingOrder{{Column: "id", Direction: "desc"}},
+		Values:   []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 101}}},
+		ExpireAt: time.Now().Add(-time.Minute).Unix(),
+	})
+	if err != nil {
+		t.Errorf("\n testing : EncodeCursor error : %v \n", err)
+		return
+	}
+
+	option := DefaultPagingOption()
+	option.Cursor = token
+
+	_, err = GetOptionCollection(option)
+
+	var cursorErr *CursorError
+	if !errors.As(err, &cursorErr) || cursorErr.Code != CursorErrorExpired {
+		t.Errorf("\n testing : GetOptionCollection expired token should fail with CursorError : %v \n", err)
+	}
+}
@@ -0,0 +1,191 @@
+package pagination
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// encode && decode cursor token
+func TestEncodeCursor(t *testing.T) {
+	cursor := &PagingCursor{
+		Columns: []*PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "id", Direction: "asc"}},
+		Values:  []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 1613577600}}, {Value: &PagingCursorValue_IntValue{IntValue: 7}}},
+		Page:    2,
+	}
+
+	token, err := EncodeCursor(cursor)
+	if err != nil {
+		t.Errorf("\n testing : EncodeCursor error : %v \n", err)
+		return
+	}
+
+	got, err := DecodeCursor(token)
+	if err != nil {
+		t.Errorf("\n testing : DecodeCursor error : %v \n", err)
+		return
+	}
+
+	if got.Version != CursorVersion2 || got.Page != 2 || got.Columns[1].Direction != "asc" || got.Values[0].GetIntValue() != 1613577600 {
+		t.Errorf("\n testing : DecodeCursor error : got %+v \n", got)
+	} else {
+		t.Logf("\n EncodeCursor result : %s\n", token)
+	}
+
+	// invalid token
+	if _, err = DecodeCursor("not-a-token"); err == nil {
+		t.Errorf("\n testing : DecodeCursor should fail with invalid token \n")
+	}
+
+	// version 1 token : float values
+	cursor.Version = CursorVersion1
+	token, err = EncodeCursor(cursor)
+	if err != nil {
+		t.Errorf("\n testing : EncodeCursor version 1 error : %v \n", err)
+		return
+	}
+
+	got, err = DecodeCursor(token)
+	if err != nil || got.Values[1].GetDoubleValue() != 7 {
+		t.Errorf("\n testing : DecodeCursor version 1 error : %v %+v \n", err, got)
+	}
+}
+
+// next_cursor && prev_cursor round trip
+func TestCursorToken(t *testing.T) {
+	type Model struct {
+		Id int64
+	}
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(2)
+	option.GotoPageNumber = proto.Int64(2)
+
+	collection, err := GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	result, err := SetPagingResult(collection, &PagingResultCollection{
+		TotalRecords: 10,
+		ResultSlice:  []Model{{Id: 8}, {Id: 7}},
+	})
+	if err != nil {
+		t.Errorf("\n testing : SetPagingResult error : %v \n", err)
+		return
+	}
+
+	if result.NextCursor == "" || result.PrevCursor == "" {
+		t.Errorf("\n testing : SetPagingResult cursor token error : %+v \n", result)
+		return
+	}
+
+	// next page
+	nextOption := DefaultPagingOption()
+	nextOption.PageSize = proto.Int64(2)
+	nextOption.Cursor = result.NextCursor
+
+	collection, err = GetOptionCollection(nextOption, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection next_cursor error : %v \n", err)
+		return
+	}
+
+	where, args := collection.Where[0].Expression()
+	if where != "id < ?" || args[0] != int64(7) || collection.IsReverse || nextOption.GetGotoPageNumber() != 3 {
+		t.Errorf("\n testing : next_cursor where error : %s %v \n", where, args)
+	}
+
+	// preceding page
+	prevOption := DefaultPagingOption()
+	prevOption.PageSize = proto.Int64(2)
+	prevOption.Cursor = result.PrevCursor
+
+	collection, err = GetOptionCollection(prevOption, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection prev_cursor error : %v \n", err)
+		return
+	}
+
+	where, args = collection.Where[0].Expression()
+	if where != "id > ?" || args[0] != int64(8) || !collection.IsReverse || collection.Order[0].Direction != "asc" {
+		t.Errorf("\n testing : prev_cursor where error : %s %v \n", where, args)
+	}
+
+	// the token page over the page number limit
+	token, err := EncodeCursor(&PagingCursor{Columns: []*PagingOrder{{Column: "id", Direction: "desc"}}, Values: []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 7}}}, Page: 999999999})
+	if err != nil {
+		t.Errorf("\n testing : EncodeCursor error : %v \n", err)
+		return
+	}
+
+	paginator := NewPaginator(WithLimits(&PagingLimits{PageNumber: PagingLimit{Max: 500}}))
+	deepOption := DefaultPagingOption()
+	deepOption.Cursor = token
+
+	var errs ValidationErrors
+	if _, err = paginator.GetOptionCollection(deepOption, &Model{}); !errors.As(err, &errs) || errs[0].Field != "cursor" {
+		t.Errorf("\n testing : GetOptionCollection deep cursor should fail with ValidationErrors : %v \n", err)
+	}
+
+	// show_from && show_to overflow
+	token, _ = EncodeCursor(&PagingCursor{Columns: []*PagingOrder{{Column: "id", Direction: "desc"}}, Values: []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 7}}}, Page: math.MaxInt64})
+	deepOption.Cursor = token
+
+	var cursorError *CursorError
+	if _, err = GetOptionCollection(deepOption, &Model{}); !errors.As(err, &cursorError) {
+		t.Errorf("\n testing : GetOptionCollection overflow cursor should fail with CursorError : %v \n", err)
+	}
+}
+
+// the cursor token of every record && start cursor && end cursor
+func TestRowCursors(t *testing.T) {
+	type Model struct {
+		Id int64
+	}
+
+	paginator := NewPaginator(WithRowCursors(true))
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(3)
+
+	collection, err := paginator.GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	result, err := paginator.SetPagingResult(collection, &PagingResultCollection{TotalRecords: 10, ResultSlice: []Model{{Id: 10}, {Id: 9}, {Id: 8}}})
+	if err != nil || len(result.RowCursors) != 3 {
+		t.Errorf("\n testing : SetPagingResult error : %v %+v \n", err, result)
+		return
+	}
+	if result.StartCursor != result.RowCursors[0] || result.EndCursor != result.RowCursors[2] || result.EndCursor != result.NextCursor {
+		t.Errorf("\n testing : start cursor && end cursor error : %+v \n", result)
+	}
+
+	// resume from the second record
+	next := DefaultPagingOption()
+	next.Cursor = result.RowCursors[1]
+	if collection, err = paginator.GetOptionCollection(next, &Model{}); err != nil {
+		t.Errorf("\n testing : GetOptionCollection row cursor error : %v \n", err)
+		return
+	}
+	if where := collection.Where[0]; where.Symbol != "<" || where.Data != int64(9) {
+		t.Errorf("\n testing : row cursor where error : %+v \n", where)
+	}
+
+	// without WithRowCursors
+	if collection, err = GetOptionCollection(option, &Model{}); err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+	if result, err = SetPagingResult(collection, &PagingResultCollection{TotalRecords: 10, ResultSlice: []Model{{Id: 8}}}); err != nil || result.RowCursors != nil || result.StartCursor == "" {
+		t.Errorf("\n testing : SetPagingResult error : %v %+v \n", err, result)
+	}
+}
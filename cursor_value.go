@@ -0,0 +1,329 @@
+package pagination
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewCursorValue : typed cursor value of the model field value
+//
+// int(8/16/32/64) => int_value ; uint(8/16/32/64) => uint_value ; float(32/64) => double_value ;
+// string => string_value ; time.Time => time_value ; [16]byte => uuid_value ;
+// driver.Valuer(sql.NullInt64, sql.NullString, sql.NullTime, decimal...) => the typed value of Valuer.Value() ,
+// a fmt.Stringer Valuer with a numeric string value is a decimal_value ;
+// nil pointer || invalid sql.NullX => null_value (the null_value cannot be encoded into the cursor token ,
+// the cursor column must be NOT NULL , or add a NOT NULL column (example : COALESCE alias))
+func NewCursorValue(value interface{}) (*PagingCursorValue, error) {
+
+	switch v := value.(type) {
+
+	case nil:
+		return &PagingCursorValue{Value: &PagingCursorValue_NullValue{NullValue: true}}, nil
+
+	case *PagingCursorValue:
+		return v, nil
+
+	case time.Time:
+		return &PagingCursorValue{Value: &PagingCursorValue_TimeValue{TimeValue: v.UTC().Format(time.RFC3339Nano)}}, nil
+
+	case []byte:
+		return &PagingCursorValue{Value: &PagingCursorValue_StringValue{StringValue: string(v)}}, nil
+
+	case int64:
+		return &PagingCursorValue{Value: &PagingCursorValue_IntValue{IntValue: v}}, nil
+
+	case int:
+		return &PagingCursorValue{Value: &PagingCursorValue_IntValue{IntValue: int64(v)}}, nil
+
+	case uint64:
+		return &PagingCursorValue{Value: &PagingCursorValue_UintValue{UintValue: v}}, nil
+
+	case float64:
+		return &PagingCursorValue{Value: &PagingCursorValue_DoubleValue{DoubleValue: v}}, nil
+
+	case string:
+		return &PagingCursorValue{Value: &PagingCursorValue_StringValue{StringValue: v}}, nil
+	}
+
+	reflectValue := reflect.ValueOf(value)
+
+	// [16]byte uuid
+	if reflectValue.Kind() == reflect.Array && reflectValue.Len() == 16 && reflectValue.Type().Elem().Kind() == reflect.Uint8 {
+		uuid := make([]byte, 16)
+		reflect.Copy(reflect.ValueOf(uuid), reflectValue)
+		return &PagingCursorValue{Value: &PagingCursorValue_UuidValue{UuidValue: uuid}}, nil
+	}
+
+	// sql.NullX , decimal ...
+	if valuer, ok := value.(driver.Valuer); ok {
+		if reflectValue.Kind() == reflect.Ptr && reflectValue.IsNil() {
+			return NewCursorValue(nil)
+		}
+		driverValue, err := valuer.Value()
+		if err != nil {
+			return nil, fmt.Errorf("CursorColumn value fail : %v", err)
+		}
+
+		// decimal : fmt.Stringer with a numeric string value (example : decimal.Decimal)
+		_, isStringer := value.(fmt.Stringer)
+		if str, ok := driverValue.(string); ok && isStringer && isDecimal(str) {
+			return &PagingCursorValue{Value: &PagingCursorValue_DecimalValue{DecimalValue: str}}, nil
+		}
+		if _, ok := driverValue.(driver.Valuer); ok {
+			return nil, fmt.Errorf("CursorColumn value type(%T) not supported", value)
+		}
+		return NewCursorValue(driverValue)
+	}
+
+	switch reflectValue.Kind() {
+
+	case reflect.Ptr:
+		if reflectValue.IsNil() {
+			return NewCursorValue(nil)
+		}
+		return NewCursorValue(reflectValue.Elem().Interface())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &PagingCursorValue{Value: &PagingCursorValue_IntValue{IntValue: reflectValue.Int()}}, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &PagingCursorValue{Value: &PagingCursorValue_UintValue{UintValue: reflectValue.Uint()}}, nil
+
+	case reflect.Float32:
+		floatValue, err := strconv.ParseFloat(fmt.Sprint(reflectValue.Interface()), 64)
+		if err != nil {
+			return nil, fmt.Errorf("CursorColumn float32 convert to float64 fail : %v", err)
+		}
+		return &PagingCursorValue{Value: &PagingCursorValue_DoubleValue{DoubleValue: floatValue}}, nil
+
+	case reflect.Float64:
+		return &PagingCursorValue{Value: &PagingCursorValue_DoubleValue{DoubleValue: reflectValue.Float()}}, nil
+
+	case reflect.String:
+		return &PagingCursorValue{Value: &PagingCursorValue_StringValue{StringValue: reflectValue.String()}}, nil
+
+	default:
+		return nil, fmt.Errorf("CursorColumn value type(%T) not supported", value)
+	}
+}
+
+// getNullCursorColumn the cursor column of the null cursor value (empty : no null value) ,
+// the keyset predicate cannot compare with NULL (a = NULL , a < NULL are unknown) , so the null cursor value is invalid
+func getNullCursorColumn(cursorColumns []*PagingOrder, cursorValues []*PagingCursorValue) (string, bool) {
+
+	for i, cursorValue := range cursorValues {
+		if cursorValue.GetValue() != nil && !cursorValue.GetNullValue() {
+			continue
+		}
+		if i < len(cursorColumns) && cursorColumns[i] != nil {
+			return cursorColumns[i].Column, true
+		}
+		return fmt.Sprintf("#%d", i), true
+	}
+	return "", false
+}
+
+// isDecimal decimal string (example : -12.30)
+func isDecimal(str string) bool {
+
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "-"), "+")
+	if str == "" || str == "." {
+		return false
+	}
+
+	dot := false
+	for i := 0; i < len(str); i++ {
+		switch {
+		case str[i] >= '0' && str[i] <= '9':
+		case str[i] == '.' && !dot:
+			dot = true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Data : the where data of the typed cursor value
+// (int64, uint64, float64, string, time.Time, string(uuid : 8-4-4-4-12), string(decimal) or nil)
+func (m *PagingCursorValue) Data() interface{} {
+
+	switch v := m.GetValue().(type) {
+
+	case *PagingCursorValue_IntValue:
+		return v.IntValue
+
+	case *PagingCursorValue_UintValue:
+		return v.UintValue
+
+	case *PagingCursorValue_DoubleValue:
+		return v.DoubleValue
+
+	case *PagingCursorValue_StringValue:
+		return v.StringValue
+
+	case *PagingCursorValue_TimeValue:
+		t, err := time.Parse(time.RFC3339Nano, v.TimeValue)
+		if err != nil {
+			return v.TimeValue
+		}
+		return t
+
+	case *PagingCursorValue_UuidValue:
+		return formatUUID(v.UuidValue)
+
+	case *PagingCursorValue_DecimalValue:
+		return v.DecimalValue
+
+	default:
+		return nil
+	}
+}
+
+// formatUUID the canonical uuid string (example : 12345678-9abc-def0-1234-56789abcdef0) ,
+// postgres uuid && mysql CHAR(36) compare with the string , not the bytes
+func formatUUID(uuid []byte) interface{} {
+
+	if len(uuid) != 16 {
+		return uuid
+	}
+	text := hex.EncodeToString(uuid)
+	return text[0:8] + "-" + text[8:12] + "-" + text[12:16] + "-" + text[16:20] + "-" + text[20:32]
+}
+
+// Float : the float64 of a numeric cursor value (PagingResult.CursorValue)
+func (m *PagingCursorValue) Float() (float64, bool) {
+
+	switch v := m.GetValue().(type) {
+
+	case *PagingCursorValue_IntValue:
+		return float64(v.IntValue), true
+
+	case *PagingCursorValue_UintValue:
+		return float64(v.UintValue), true
+
+	case *PagingCursorValue_DoubleValue:
+		return v.DoubleValue, true
+
+	case *PagingCursorValue_DecimalValue:
+		floatValue, err := strconv.ParseFloat(v.DecimalValue, 64)
+		return floatValue, err == nil
+
+	case *PagingCursorValue_StringValue:
+		floatValue, err := strconv.ParseFloat(v.StringValue, 64)
+		return floatValue, err == nil
+
+	default:
+		return 0, false
+	}
+}
+
+// cursor value text type (cursor token)
+const (
+	cursorTextInt     = "i" // int_value
+	cursorTextUint    = "u" // uint_value
+	cursorTextDouble  = "f" // double_value
+	cursorTextString  = "s" // string_value
+	cursorTextTime    = "t" // time_value
+	cursorTextUUID    = "g" // uuid_value
+	cursorTextDecimal = "d" // decimal_value
+	cursorTextNull    = "n" // null_value
+)
+
+// formatCursorValue cursor value => text (example : i:1357924680135792468)
+func formatCursorValue(m *PagingCursorValue) string {
+
+	switch v := m.GetValue().(type) {
+
+	case *PagingCursorValue_IntValue:
+		return cursorTextInt + ":" + strconv.FormatInt(v.IntValue, 10)
+
+	case *PagingCursorValue_UintValue:
+		return cursorTextUint + ":" + strconv.FormatUint(v.UintValue, 10)
+
+	case *PagingCursorValue_DoubleValue:
+		return cursorTextDouble + ":" + strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+
+	case *PagingCursorValue_StringValue:
+		return cursorTextString + ":" + v.StringValue
+
+	case *PagingCursorValue_TimeValue:
+		return cursorTextTime + ":" + v.TimeValue
+
+	case *PagingCursorValue_UuidValue:
+		return cursorTextUUID + ":" + hex.EncodeToString(v.UuidValue)
+
+	case *PagingCursorValue_DecimalValue:
+		return cursorTextDecimal + ":" + v.DecimalValue
+
+	default:
+		return cursorTextNull + ":"
+	}
+}
+
+// parseCursorValue text => cursor value
+func parseCursorValue(text string) (*PagingCursorValue, error) {
+
+	i := strings.Index(text, ":")
+	if i < 0 {
+		return nil, fmt.Errorf("cursor value(%s) invalid", text)
+	}
+	valueType, value := text[:i], text[i+1:]
+
+	switch valueType {
+
+	case cursorTextInt:
+		intValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cursor value(%s) invalid : %v", text, err)
+		}
+		return &PagingCursorValue{Value: &PagingCursorValue_IntValue{IntValue: intValue}}, nil
+
+	case cursorTextUint:
+		uintValue, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cursor value(%s) invalid : %v", text, err)
+		}
+		return &PagingCursorValue{Value: &PagingCursorValue_UintValue{UintValue: uintValue}}, nil
+
+	case cursorTextDouble:
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cursor value(%s) invalid : %v", text, err)
+		}
+		return &PagingCursorValue{Value: &PagingCursorValue_DoubleValue{DoubleValue: floatValue}}, nil
+
+	case cursorTextString:
+		return &PagingCursorValue{Value: &PagingCursorValue_StringValue{StringValue: value}}, nil
+
+	case cursorTextTime:
+		if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+			return nil, fmt.Errorf("cursor value(%s) invalid : %v", text, err)
+		}
+		return &PagingCursorValue{Value: &PagingCursorValue_TimeValue{TimeValue: value}}, nil
+
+	case cursorTextUUID:
+		uuid, err := hex.DecodeString(value)
+		if err != nil || len(uuid) != 16 {
+			return nil, fmt.Errorf("cursor value(%s) invalid uuid", text)
+		}
+		return &PagingCursorValue{Value: &PagingCursorValue_UuidValue{UuidValue: uuid}}, nil
+
+	case cursorTextDecimal:
+		if !isDecimal(value) {
+			return nil, fmt.Errorf("cursor value(%s) invalid decimal", text)
+		}
+		return &PagingCursorValue{Value: &PagingCursorValue_DecimalValue{DecimalValue: value}}, nil
+
+	case cursorTextNull:
+		return &PagingCursorValue{Value: &PagingCursorValue_NullValue{NullValue: true}}, nil
+
+	default:
+		return nil, fmt.Errorf("cursor value(%s) invalid type", text)
+	}
+}
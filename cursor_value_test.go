@@ -0,0 +1,176 @@
+package pagination
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// decimal value (example : decimal.Decimal)
+type testDecimal string
+
+func (d testDecimal) String() string { return string(d) }
+
+func (d testDecimal) Value() (driver.Value, error) { return string(d), nil }
+
+// typed cursor value
+func TestNewTypedCursorValue(t *testing.T) {
+	createdAt := time.Date(2021, 2, 18, 8, 0, 0, 123, time.UTC)
+
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{int64(1357924680135792468), "i:1357924680135792468"},
+		{uint64(18446744073709551615), "u:18446744073709551615"},
+		{float32(1.5), "f:1.5"},
+		{"abc", "s:abc"},
+		{createdAt, "t:2021-02-18T08:00:00.000000123Z"},
+		{[16]byte{0: 0x12, 15: 0x34}, "g:12000000000000000000000000000034"},
+		{sql.NullInt64{Int64: 7, Valid: true}, "i:7"},
+		{sql.NullInt64{}, "n:"},
+		{sql.NullString{String: "12", Valid: true}, "s:12"},
+		{testDecimal("12.30"), "d:12.30"},
+		{(*int64)(nil), "n:"},
+	}
+
+	for _, test := range tests {
+		got, err := NewCursorValue(test.value)
+		if err != nil {
+			t.Errorf("\n testing : NewCursorValue(%T) error : %v \n", test.value, err)
+			continue
+		}
+
+		text := formatCursorValue(got)
+		if text != test.want {
+			t.Errorf("\n testing : NewCursorValue(%T) error : got %s, want %s \n", test.value, text, test.want)
+			continue
+		}
+
+		parsed, err := parseCursorValue(text)
+		if err != nil || formatCursorValue(parsed) != text {
+			t.Errorf("\n testing : parseCursorValue(%s) error : %v \n", text, err)
+		}
+	}
+
+	// not supported
+	if _, err := NewCursorValue(true); err == nil {
+		t.Errorf("\n testing : NewCursorValue(bool) should fail \n")
+	}
+
+	// the where data of the uuid : the canonical string
+	uuid, _ := NewCursorValue([16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0})
+	if data := uuid.Data(); data != "12345678-9abc-def0-1234-56789abcdef0" {
+		t.Errorf("\n testing : uuid Data error : %v \n", data)
+	}
+}
+
+// typed cursor value : snowflake id && time.Time
+func TestTypedCursorValue(t *testing.T) {
+	type Model struct {
+		CreatedAt time.Time
+		Id        int64
+	}
+
+	createdAt := time.Date(2021, 2, 18, 8, 0, 0, 0, time.UTC)
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(1)
+	option.CursorColumns = []*PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "id", Direction: "desc"}}
+
+	collection, err := GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	result, err := SetPagingResult(collection, &PagingResultCollection{
+		TotalRecords: 2,
+		ResultSlice:  []*Model{{CreatedAt: createdAt, Id: 1357924680135792468}},
+	})
+	if err != nil {
+		t.Errorf("\n testing : SetPagingResult error : %v \n", err)
+		return
+	}
+
+	if len(result.CursorTypedValues) != 2 || result.CursorTypedValues[1].GetIntValue() != 1357924680135792468 {
+		t.Errorf("\n testing : SetPagingResult CursorTypedValues error : %v \n", result.CursorTypedValues)
+		return
+	}
+
+	// next page
+	option = DefaultPagingOption()
+	option.Cursor = result.NextCursor
+
+	collection, err = GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	_, args := collection.Where[0].Expression()
+	if args[0] != createdAt || args[2] != int64(1357924680135792468) {
+		t.Errorf("\n testing : typed cursor where data error : %v \n", args)
+	} else {
+		t.Logf("\n typed cursor where data : %v\n", args)
+	}
+}
+
+// nullable cursor column : the null cursor value is rejected (the keyset predicate cannot compare with NULL)
+func TestNullCursorValue(t *testing.T) {
+	type Model struct {
+		CreatedAt sql.NullInt64
+		Id        int64
+	}
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(1)
+	option.CursorColumns = []*PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "id", Direction: "desc"}}
+
+	collection, err := GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	// the null value of the last record
+	if _, err = SetPagingResult(collection, &PagingResultCollection{TotalRecords: 2, ResultSlice: []*Model{{Id: 3}}}); err == nil {
+		t.Errorf("\n testing : SetPagingResult should fail with the null cursor value \n")
+	}
+
+	// the not null value
+	result, err := SetPagingResult(collection, &PagingResultCollection{TotalRecords: 2, ResultSlice: []*Model{{CreatedAt: sql.NullInt64{Int64: 7, Valid: true}, Id: 3}}})
+	if err != nil || result.NextCursor == "" {
+		t.Errorf("\n testing : SetPagingResult error : %v %+v \n", err, result)
+	}
+
+	// the token of the null value
+	token, err := encodeCursorToken(&PagingCursor{
+		Version: CursorVersion2,
+		Columns: option.CursorColumns,
+		Values:  []*PagingCursorValue{{Value: &PagingCursorValue_NullValue{NullValue: true}}, {Value: &PagingCursorValue_IntValue{IntValue: 3}}},
+		Page:    2,
+	}, nil)
+	if err != nil {
+		t.Errorf("\n testing : encodeCursorToken error : %v \n", err)
+		return
+	}
+	var cursorError *CursorError
+	if _, err = DecodeCursor(token); !errors.As(err, &cursorError) {
+		t.Errorf("\n testing : DecodeCursor should fail with the null cursor value : %v \n", err)
+	}
+
+	// the typed values of the paging option
+	option.CurrentPageNumber = 1
+	option.GotoPageNumber = proto.Int64(2)
+	option.CursorTypedValues = []*PagingCursorValue{{Value: &PagingCursorValue_NullValue{NullValue: true}}, {Value: &PagingCursorValue_IntValue{IntValue: 3}}}
+	if _, err = GetOptionCollection(option, &Model{}); err == nil {
+		t.Errorf("\n testing : GetOptionCollection should fail with the null cursor value \n")
+	}
+}
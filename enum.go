@@ -0,0 +1,170 @@
+package pagination
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePagingMode : the paging mode name => PagingMode , case-insensitive ,
+// the enum name (PAGING_MODE_CURSOR) , the short name (cursor) or the legacy number (2)
+//
+// the empty name is PAGING_MODE_UNSPECIFIED , the unknown name returns error
+func ParsePagingMode(name string) (PagingMode, error) {
+
+	value, err := parseEnum(name, "PAGING_MODE_", PagingMode_value)
+	if err != nil {
+		return PagingMode_PAGING_MODE_UNSPECIFIED, fmt.Errorf("paging mode(%s) must be number or cursor", name)
+	}
+	return PagingMode(value), nil
+}
+
+// ParseSortDirection : the direction name => SortDirection , case-insensitive ,
+// the legacy direction (asc, desc) , the enum name (SORT_DIRECTION_ASC) or the number (1)
+//
+// the empty name is SORT_DIRECTION_UNSPECIFIED , the unknown name returns error
+func ParseSortDirection(name string) (SortDirection, error) {
+
+	value, err := parseEnum(name, "SORT_DIRECTION_", SortDirection_value)
+	if err != nil {
+		return SortDirection_SORT_DIRECTION_UNSPECIFIED, fmt.Errorf("direction(%s) must be asc or desc", name)
+	}
+	return SortDirection(value), nil
+}
+
+// parseEnum the enum name , the short name (without the prefix) or the number => the enum value
+func parseEnum(name, prefix string, values map[string]int32) (int32, error) {
+
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" {
+		return 0, nil
+	}
+
+	// number
+	if number, err := strconv.ParseInt(name, 10, 32); err == nil {
+		for _, value := range values {
+			if value == int32(number) {
+				return value, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown enum number(%d)", number)
+	}
+
+	// the enum name , the short name
+	if value, ok := values[name]; ok {
+		return value, nil
+	}
+	if value, ok := values[prefix+name]; ok && value != 0 {
+		return value, nil
+	}
+	return 0, fmt.Errorf("unknown enum name(%s)", name)
+}
+
+// UnmarshalJSON : the json number , the enum name or the short name (case-insensitive)
+func (x *PagingMode) UnmarshalJSON(data []byte) error {
+
+	name, err := unmarshalEnumJSON(data)
+	if err != nil {
+		return err
+	}
+	if *x, err = ParsePagingMode(name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UnmarshalJSON : the json number , the enum name or the legacy direction (case-insensitive)
+func (x *SortDirection) UnmarshalJSON(data []byte) error {
+
+	name, err := unmarshalEnumJSON(data)
+	if err != nil {
+		return err
+	}
+	if *x, err = ParseSortDirection(name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// unmarshalEnumJSON the json string or number => the enum name
+func unmarshalEnumJSON(data []byte) (string, error) {
+
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		return name, nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return "", fmt.Errorf("enum must be a json string or number : %s", data)
+	}
+	return number.String(), nil
+}
+
+// Direction : the legacy direction : asc or desc (empty : SORT_DIRECTION_UNSPECIFIED)
+func (x SortDirection) Direction() string {
+
+	switch x {
+	case SortDirection_SORT_DIRECTION_ASC:
+		return defaultOrderAsc
+	case SortDirection_SORT_DIRECTION_DESC:
+		return defaultOrderDesc
+	default:
+		return ""
+	}
+}
+
+// getSortDirection the sort direction , override the legacy direction
+func getSortDirection(direction string, sortDirection SortDirection) (SortDirection, error) {
+
+	if sortDirection != SortDirection_SORT_DIRECTION_UNSPECIFIED {
+		if _, ok := SortDirection_name[int32(sortDirection)]; !ok {
+			return sortDirection, fmt.Errorf("sort direction(%d) must be asc or desc", sortDirection)
+		}
+		return sortDirection, nil
+	}
+	return ParseSortDirection(direction)
+}
+
+// checkPagingEnums the unknown paging mode && direction return ValidationErrors (instead of the default values) ,
+// the legacy directions are normalized (example : ASC => asc)
+func checkPagingEnums(pagingOption *PagingOption) ValidationErrors {
+	var errs ValidationErrors
+
+	invalid := func(field string, value interface{}, reason string) {
+		errs = append(errs, &ValidationError{Field: field, Value: fmt.Sprint(value), Reason: reason})
+	}
+
+	// paging mode
+	if _, ok := PagingMode_name[int32(pagingOption.PagingMode)]; !ok {
+		invalid("paging_mode", int32(pagingOption.PagingMode), fmt.Sprintf("must be %d or %d", PagingModeNumber, PagingModeCursor))
+	}
+
+	// direction
+	order := func(field string, direction *string, sortDirection *SortDirection) {
+		value, err := getSortDirection(*direction, *sortDirection)
+		if err != nil && *sortDirection != SortDirection_SORT_DIRECTION_UNSPECIFIED {
+			invalid(strings.Replace(field, "direction", "sort_direction", 1), int32(*sortDirection), err.Error())
+			return
+		}
+		if err != nil {
+			invalid(field, *direction, err.Error())
+			return
+		}
+		if value != SortDirection_SORT_DIRECTION_UNSPECIFIED {
+			*direction, *sortDirection = value.Direction(), value
+		}
+	}
+	order("cursor_direction", &pagingOption.CursorDirection, &pagingOption.CursorSortDirection)
+	for i, cursorColumn := range pagingOption.CursorColumns {
+		if cursorColumn != nil {
+			order(fmt.Sprintf("cursor_columns[%d].direction", i), &cursorColumn.Direction, &cursorColumn.SortDirection)
+		}
+	}
+	for i, orderBy := range pagingOption.OrderBy {
+		if orderBy != nil {
+			order(fmt.Sprintf("order_by[%d].direction", i), &orderBy.Direction, &orderBy.SortDirection)
+		}
+	}
+	return errs
+}
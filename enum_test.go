@@ -0,0 +1,102 @@
+package pagination
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// the legacy strings && the json names => enums (case-insensitive)
+func TestParseEnum(t *testing.T) {
+	t.Parallel()
+
+	for name, want := range map[string]SortDirection{
+		"":                    SortDirection_SORT_DIRECTION_UNSPECIFIED,
+		"asc":                 SortDirection_SORT_DIRECTION_ASC,
+		" ASC ":               SortDirection_SORT_DIRECTION_ASC,
+		"Desc":                SortDirection_SORT_DIRECTION_DESC,
+		"SORT_DIRECTION_ASC":  SortDirection_SORT_DIRECTION_ASC,
+		"sort_direction_desc": SortDirection_SORT_DIRECTION_DESC,
+		"2":                   SortDirection_SORT_DIRECTION_DESC,
+	} {
+		if direction, err := ParseSortDirection(name); err != nil || direction != want {
+			t.Errorf("\n testing : ParseSortDirection(%s) error : %v %v \n", name, err, direction)
+		}
+	}
+	for _, name := range []string{"up", "ascending", "unspecified", "3", "-1"} {
+		if _, err := ParseSortDirection(name); err == nil {
+			t.Errorf("\n testing : ParseSortDirection(%s) should fail \n", name)
+		}
+	}
+
+	for name, want := range map[string]PagingMode{
+		"":                   PagingMode_PAGING_MODE_UNSPECIFIED,
+		"cursor":             PagingModeCursor,
+		"NUMBER":             PagingModeNumber,
+		"paging_mode_cursor": PagingModeCursor,
+		"1":                  PagingModeNumber,
+	} {
+		if mode, err := ParsePagingMode(name); err != nil || mode != want {
+			t.Errorf("\n testing : ParsePagingMode(%s) error : %v %v \n", name, err, mode)
+		}
+	}
+	if _, err := ParsePagingMode("offset"); err == nil {
+		t.Errorf("\n testing : ParsePagingMode(offset) should fail \n")
+	}
+}
+
+// encoding/json : the legacy number && the names ; protojson : the enum names
+func TestEnumJSON(t *testing.T) {
+	t.Parallel()
+
+	option := new(PagingOption)
+	err := json.Unmarshal([]byte(`{"paging_mode":2,"order_by":[{"column":"id","sort_direction":"DESC"},{"column":"name","sort_direction":1}]}`), option)
+	if err != nil || option.PagingMode != PagingModeCursor ||
+		option.OrderBy[0].SortDirection != SortDirection_SORT_DIRECTION_DESC || option.OrderBy[1].SortDirection != SortDirection_SORT_DIRECTION_ASC {
+		t.Errorf("\n testing : json.Unmarshal error : %v %+v \n", err, option)
+	}
+	if err = json.Unmarshal([]byte(`{"paging_mode":"offset"}`), option); err == nil {
+		t.Errorf("\n testing : json.Unmarshal unknown paging mode should fail \n")
+	}
+
+	option = new(PagingOption)
+	err = protojson.Unmarshal([]byte(`{"pagingMode":"PAGING_MODE_CURSOR","cursorSortDirection":"SORT_DIRECTION_ASC"}`), option)
+	if err != nil || option.PagingMode != PagingModeCursor || option.CursorSortDirection != SortDirection_SORT_DIRECTION_ASC {
+		t.Errorf("\n testing : protojson.Unmarshal error : %v %+v \n", err, option)
+	}
+}
+
+// the sort direction override the legacy direction , the unknown direction is invalid
+func TestSortDirection(t *testing.T) {
+	t.Parallel()
+
+	option := &PagingOption{
+		PagingMode:          PagingModeCursor,
+		CursorDirection:     "asc",
+		CursorSortDirection: SortDirection_SORT_DIRECTION_DESC,
+		OrderBy:             []*PagingOrder{{Column: "id", Direction: "ASC"}},
+	}
+	if err := InitPagingOption(option); err != nil || option.CursorDirection != "desc" || option.OrderBy[0].Direction != "asc" ||
+		option.OrderBy[0].SortDirection != SortDirection_SORT_DIRECTION_ASC {
+		t.Errorf("\n testing : InitPagingOption error : %v %+v \n", err, option)
+	}
+
+	err := InitPagingOption(&PagingOption{OrderBy: []*PagingOrder{{Column: "id", SortDirection: 5}}})
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || errs[0].Field != "order_by[0].sort_direction" {
+		t.Errorf("\n testing : InitPagingOption unknown sort direction error : %v \n", err)
+	}
+
+	// the cursor token of the unknown direction
+	token, err := EncodeCursor(&PagingCursor{Columns: []*PagingOrder{{Column: "id", Direction: "up"}}, Values: []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 1}}}})
+	if err != nil {
+		t.Errorf("\n testing : EncodeCursor error : %v \n", err)
+		return
+	}
+	var cursorError *CursorError
+	if _, err = DecodeCursor(token); !errors.As(err, &cursorError) {
+		t.Errorf("\n testing : DecodeCursor unknown direction error : %v \n", err)
+	}
+}
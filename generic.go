@@ -0,0 +1,193 @@
+package pagination
+
+import "fmt"
+
+// CursorFunc : the cursor values of the record, in the order of the cursor columns
+// (PagingOption.CursorColumns or PagingOption.CursorColumn)
+//
+// example : func(user *User) []interface{} { return []interface{}{user.CreatedAt, user.Id} }
+type CursorFunc[T any] func(record T) []interface{}
+
+// Page : paging result with the typed records
+type Page[T any] struct {
+	Items       []T           // records of the page
+	Result      *PagingResult // paging result
+	Approximate bool          // the total records is approximate (PagingCount.Approximate)
+}
+
+// NewPage : the typed SetPagingResult ,
+// the cursor values come from cursorFunc without reflection (cursorFunc can be nil in page number mode)
+//
+// example :
+//			page, err := pagination.NewPage(collection, users, total, func(user *User) []interface{} {
+//				return []interface{}{user.Id}
+//			})
+func NewPage[T any](optionCollection *PagingOptionCollection, items []T, totalRecords int64, cursorFunc CursorFunc[T]) (*Page[T], error) {
+	return NewCountPage(optionCollection, items, &PagingCount{Total: totalRecords}, cursorFunc)
+}
+
+// NewCountPage : NewPage with the count of the count strategy (PagingOptionCollection.Count) ,
+// the approximate total records (estimated or capped count) is kept
+//
+// example :
+//			count, err := collection.Count(ctx, query)
+//			// query users with the collection
+//			page, err := pagination.NewCountPage(collection, users, count, cursorFunc)
+//			// page.Approximate : the total records is approximate
+func NewCountPage[T any](optionCollection *PagingOptionCollection, items []T, count *PagingCount, cursorFunc CursorFunc[T]) (*Page[T], error) {
+
+	if optionCollection == nil {
+		return nil, fmt.Errorf("PagingOptionCollection cannot be a nil pointer")
+	}
+	if count == nil {
+		return nil, fmt.Errorf("PagingCount cannot be a nil pointer")
+	}
+
+	// peek mode : trim the extra record
+	hasMore := false
+	if optionCollection.Peek && int64(len(items)) > optionCollection.Option.GetPageSize() {
+		items, hasMore = items[:optionCollection.Option.GetPageSize()], true
+	}
+
+	// goto preceding page : keep data sort same as paging option cursor direction ,
+	// the items && the row cursors are in the same order
+	if optionCollection.IsReverse {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	paginator := optionCollection.getPaginator()
+	pagingResult, err := paginator.setPagingResult(optionCollection, count, hasMore, func() (*PagingResultInfo, error) {
+		return calcPageItems(optionCollection, items, cursorFunc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the cursor token of every record
+	if paginator.rowCursors && optionCollection.Option.PagingMode == PagingModeCursor {
+		if cursorFunc == nil {
+			return nil, fmt.Errorf("CursorFunc cannot be nil in cursor mode")
+		}
+		for _, item := range items {
+			cursorValues, err := getRecordCursorValues(optionCollection.Option, cursorFunc(item))
+			if err != nil {
+				return nil, err
+			}
+			token, err := paginator.encodeRowCursor(optionCollection, pagingResult, cursorValues)
+			if err != nil {
+				return nil, err
+			}
+			pagingResult.RowCursors = append(pagingResult.RowCursors, token)
+		}
+	}
+	return &Page[T]{Items: items, Result: pagingResult, Approximate: count.Approximate}, nil
+}
+
+// calcPageItems calc the typed records (same as DefaultCalcResultSliceHandler , the items are reversed by NewCountPage)
+func calcPageItems[T any](optionCollection *PagingOptionCollection, items []T, cursorFunc CursorFunc[T]) (*PagingResultInfo, error) {
+	var res = &PagingResultInfo{SliceLen: int64(len(items))}
+
+	// empty list
+	if len(items) == 0 {
+		return res, nil
+	}
+
+	// not cursor mode
+	if optionCollection.Option.PagingMode != PagingModeCursor {
+		return res, nil
+	}
+
+	if cursorFunc == nil {
+		return nil, fmt.Errorf("CursorFunc cannot be nil in cursor mode")
+	}
+
+	// CursorValue
+	cursorValues, err := getRecordCursorValues(optionCollection.Option, cursorFunc(items[len(items)-1]))
+	if err != nil {
+		return nil, err
+	}
+	res.CursorTypedValues = cursorValues
+
+	// numeric CursorValue
+	for _, cursorValue := range cursorValues {
+		floatValue, _ := cursorValue.Float()
+		res.CursorValues = append(res.CursorValues, floatValue)
+	}
+	if len(res.CursorValues) > 0 {
+		res.CursorValue = res.CursorValues[0]
+	}
+
+	// first record CursorValue
+	firstCursorValues, err := getRecordCursorValues(optionCollection.Option, cursorFunc(items[0]))
+	if err != nil {
+		return nil, err
+	}
+	res.FirstCursorTypedValues = firstCursorValues
+
+	return res, nil
+}
+
+// getRecordCursorValues typed cursor values of the CursorFunc values
+func getRecordCursorValues(pagingOption *PagingOption, values []interface{}) ([]*PagingCursorValue, error) {
+
+	cursorColumns := getCursorColumns(pagingOption)
+	if len(values) != len(cursorColumns) {
+		return nil, fmt.Errorf("CursorFunc return %d values, but have %d cursor columns", len(values), len(cursorColumns))
+	}
+
+	cursorValues := make([]*PagingCursorValue, 0, len(values))
+	for _, value := range values {
+		cursorValue, err := NewCursorValue(value)
+		if err != nil {
+			return nil, err
+		}
+		cursorValues = append(cursorValues, cursorValue)
+	}
+	return cursorValues, nil
+}
+
+// TypedPaginator : paginate the typed records with the CursorFunc
+//
+// example :
+//			paginator := pagination.NewTypedPaginator(func(user *User) []interface{} { return []interface{}{user.Id} })
+//			collection, err := paginator.Collection(option)
+//			// query users with the collection
+//			page, err := paginator.Page(collection, users, total)
+type TypedPaginator[T any] struct {
+	CursorFunc CursorFunc[T] // cursor values of the record
+	Paginator  *Paginator    // settings of the paging (nil : the package-level settings)
+}
+
+// NewTypedPaginator : typed paginator with the package-level settings
+func NewTypedPaginator[T any](cursorFunc CursorFunc[T]) *TypedPaginator[T] {
+	return &TypedPaginator[T]{CursorFunc: cursorFunc}
+}
+
+// NewTypedPaginatorWith : typed paginator with the settings of the paginator
+//
+// example :
+//			paginator := pagination.NewTypedPaginatorWith(pagination.NewPaginator(pagination.WithPageSize(20)), cursorFunc)
+func NewTypedPaginatorWith[T any](paginator *Paginator, cursorFunc CursorFunc[T]) *TypedPaginator[T] {
+	return &TypedPaginator[T]{CursorFunc: cursorFunc, Paginator: paginator}
+}
+
+// Collection : GetOptionCollection of the Paginator ,
+// the cursor columns are not checked with the model, the CursorFunc provides the cursor values
+func (paginator *TypedPaginator[T]) Collection(pagingOption *PagingOption) (*PagingOptionCollection, error) {
+	if paginator.Paginator == nil {
+		return defaultPaginator().GetOptionCollection(pagingOption)
+	}
+	return paginator.Paginator.GetOptionCollection(pagingOption)
+}
+
+// Page : NewPage with the CursorFunc
+func (paginator *TypedPaginator[T]) Page(optionCollection *PagingOptionCollection, items []T, totalRecords int64) (*Page[T], error) {
+	return NewPage(optionCollection, items, totalRecords, paginator.CursorFunc)
+}
+
+// CountPage : NewCountPage with the CursorFunc
+func (paginator *TypedPaginator[T]) CountPage(optionCollection *PagingOptionCollection, items []T, count *PagingCount) (*Page[T], error) {
+	return NewCountPage(optionCollection, items, count, paginator.CursorFunc)
+}
@@ -0,0 +1,167 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// typed paging result
+func TestNewPage(t *testing.T) {
+	type User struct {
+		ID        int64
+		CreatedAt time.Time
+	}
+
+	paginator := NewTypedPaginator(func(user *User) []interface{} { return []interface{}{user.CreatedAt, user.ID} })
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(2)
+	option.CursorColumns = []*PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "id", Direction: "desc"}}
+
+	collection, err := paginator.Collection(option)
+	if err != nil {
+		t.Errorf("\n testing : Collection error : %v \n", err)
+		return
+	}
+
+	createdAt := time.Date(2021, 2, 18, 8, 0, 0, 0, time.UTC)
+	page, err := paginator.Page(collection, []*User{{ID: 9, CreatedAt: createdAt}, {ID: 8, CreatedAt: createdAt}}, 5)
+	if err != nil {
+		t.Errorf("\n testing : Page error : %v \n", err)
+		return
+	}
+
+	if page.Items[1].ID != 8 || page.Result.LastPage != 3 || page.Result.NextCursor == "" ||
+		page.Result.CursorTypedValues[0].GetTimeValue() != "2021-02-18T08:00:00Z" || page.Result.CursorTypedValues[1].GetIntValue() != 8 {
+		t.Errorf("\n testing : Page error : %+v \n", page.Result)
+	}
+
+	// the next page from the token
+	nextOption := DefaultPagingOption()
+	nextOption.PageSize = proto.Int64(2)
+	nextOption.Cursor = page.Result.NextCursor
+
+	collection, err = paginator.Collection(nextOption)
+	if err != nil {
+		t.Errorf("\n testing : Collection next_cursor error : %v \n", err)
+		return
+	}
+
+	where, args := collection.Where[0].Expression()
+	if where != "(created_at < ? OR (created_at = ? AND id < ?))" || args[0] != createdAt || args[2] != int64(8) {
+		t.Errorf("\n testing : next_cursor where error : %s %v \n", where, args)
+	}
+
+	// wrong cursor values count
+	_, err = NewPage(collection, []*User{{ID: 7}}, 5, func(user *User) []interface{} { return []interface{}{user.ID} })
+	if err == nil {
+		t.Errorf("\n testing : NewPage should fail with wrong cursor values count \n")
+	}
+
+	// row cursors without CursorFunc
+	rowOption := DefaultPagingOption()
+	rowOption.PagingMode = PagingModeCursor
+
+	collection, _ = NewPaginator(WithRowCursors(true)).GetOptionCollection(rowOption)
+	if _, err = NewPage[User](collection, nil, 0, nil); err == nil {
+		t.Errorf("\n testing : NewPage row cursors should fail without CursorFunc \n")
+	}
+
+	// preceding page without the total records : the row cursors in the order of the items
+	rowPaginator := NewPaginator(WithRowCursors(true))
+	prevOption := DefaultPagingOption()
+	prevOption.PagingMode = PagingModeCursor
+	prevOption.PageSize = proto.Int64(2)
+	prevOption.CurrentPageNumber = 3
+	prevOption.GotoPageNumber = proto.Int64(2)
+	prevOption.CursorValue = 5
+
+	collection, err = rowPaginator.GetOptionCollection(prevOption)
+	if err != nil || !collection.IsReverse {
+		t.Errorf("\n testing : GetOptionCollection preceding page error : %v %+v \n", err, collection)
+		return
+	}
+	prevPage, err := NewPage(collection, []*User{{ID: 6}, {ID: 7}}, 0, func(user *User) []interface{} { return []interface{}{user.ID} })
+	if err != nil || prevPage.Items[0].ID != 7 || len(prevPage.Result.RowCursors) != 2 {
+		t.Errorf("\n testing : NewPage preceding page error : %v %+v \n", err, prevPage)
+		return
+	}
+	for i, item := range prevPage.Items {
+		cursor, err := DecodeCursor(prevPage.Result.RowCursors[i])
+		if err != nil || cursor.Values[0].GetIntValue() != item.ID {
+			t.Errorf("\n testing : NewPage preceding page row cursor[%d] error : %v %+v \n", i, err, cursor)
+		}
+	}
+
+	// page number mode without CursorFunc
+	numberOption := DefaultPagingOption()
+	numberOption.GotoPageNumber = proto.Int64(3)
+
+	collection, _ = GetOptionCollection(numberOption)
+	numberPage, err := NewPage[User](collection, []User{{ID: 1}}, 31, nil)
+	if err != nil || numberPage.Result.ShowFrom != 31 || numberPage.Items[0].ID != 1 {
+		t.Errorf("\n testing : NewPage number mode error : %v %+v \n", err, numberPage)
+	}
+}
+
+// typed paging with the settings of the paginator
+func TestNewTypedPaginatorWith(t *testing.T) {
+	type User struct {
+		ID int64
+	}
+
+	paginator := NewTypedPaginatorWith(NewPaginator(WithPageSize(4), WithRowCursors(true)), func(user *User) []interface{} { return []interface{}{user.ID} })
+
+	option := &PagingOption{PagingMode: PagingModeCursor}
+	collection, err := paginator.Collection(option)
+	if err != nil {
+		t.Errorf("\n testing : Collection error : %v \n", err)
+		return
+	}
+	if collection.Limit != 4 || collection.Option.GetPageSize() != 4 {
+		t.Errorf("\n testing : Collection page size error : %+v \n", collection)
+	}
+
+	page, err := paginator.Page(collection, []*User{{ID: 9}, {ID: 8}}, 2)
+	if err != nil || len(page.Result.RowCursors) != 2 {
+		t.Errorf("\n testing : Page row cursors error : %v %+v \n", err, page)
+	}
+}
+
+// typed paging result with the approximate count
+func TestNewCountPage(t *testing.T) {
+	type User struct {
+		ID int64
+	}
+
+	paginator := NewTypedPaginator(func(user *User) []interface{} { return []interface{}{user.ID} })
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(2)
+
+	collection, err := paginator.Collection(option)
+	if err != nil {
+		t.Errorf("\n testing : Collection error : %v \n", err)
+		return
+	}
+
+	// capped count : the full page may have the next page
+	page, err := paginator.CountPage(collection, []*User{{ID: 9}, {ID: 8}}, &PagingCount{Total: 2, Approximate: true})
+	if err != nil || !page.Approximate || !page.Result.TotalSizeApproximate || !page.Result.HasNextPage {
+		t.Errorf("\n testing : CountPage approximate error : %v %+v \n", err, page)
+	}
+
+	// exact count
+	page, err = NewPage(collection, []*User{{ID: 9}, {ID: 8}}, 2, paginator.CursorFunc)
+	if err != nil || page.Approximate || page.Result.TotalSizeApproximate || page.Result.HasNextPage {
+		t.Errorf("\n testing : NewPage exact error : %v %+v \n", err, page)
+	}
+
+	if _, err = NewCountPage[*User](collection, nil, nil, nil); err == nil {
+		t.Errorf("\n testing : NewCountPage should fail with nil count \n")
+	}
+}
@@ -1,3 +1,7 @@
 module github.com/ikaiguang/go-pagination
 
-require github.com/golang/protobuf v1.2.0
+go 1.18
+
+require google.golang.org/protobuf v1.33.0
+
+require github.com/google/go-cmp v0.6.0 // indirect
@@ -0,0 +1,170 @@
+package pagination
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultStrictMode : InitPagingOption return ValidationErrors of the invalid fields ,
+// instead of rewriting them to the default values (default : false)
+//
+// the zero value is the unset field, and still use the default value
+var DefaultStrictMode bool
+
+// DefaultPagingLimits : the limits of InitPagingOption (default : nil, no limit)
+var DefaultPagingLimits *PagingLimits
+
+// PagingLimit : the max value , clamp the value to the max value or reject it
+type PagingLimit struct {
+	Max    int64 // max value (0 : no limit)
+	Reject bool  // reject the value with ValidationErrors (default : false, clamp)
+}
+
+// PagingLimits : the paging option limits
+//
+// example :
+//			pagination.DefaultPagingLimits = &pagination.PagingLimits{
+//				PageSize:   pagination.PagingLimit{Max: 100},
+//				Offset:     pagination.PagingLimit{Max: 10000, Reject: true},
+//				CursorJump: pagination.PagingLimit{Max: 5},
+//			}
+type PagingLimits struct {
+	PageSize   PagingLimit // max page_size
+	PageNumber PagingLimit // max goto_page_number (page depth) of page number mode && the first page of cursor mode
+	Offset     PagingLimit // max offset ((goto_page_number - 1) * page_size) , the cursor mode : the offset of the jump distance
+	CursorJump PagingLimit // max distance of goto_page_number && current_page_number of cursor mode
+}
+
+// checkPagingOption the strict mode validation before init
+func checkPagingOption(pagingOption *PagingOption) error {
+	errs := checkPagingEnums(pagingOption)
+
+	invalid := func(field string, value interface{}, reason string) {
+		errs = append(errs, &ValidationError{Field: field, Value: fmt.Sprint(value), Reason: reason})
+	}
+
+	if pagingOption.CurrentPageNumber < 0 {
+		invalid("current_page_number", pagingOption.CurrentPageNumber, "must be greater than or equal to 0")
+	}
+	// explicit presence : the unset field is the default value , the explicit 0 is invalid
+	if pagingOption.GotoPageNumber != nil && pagingOption.GetGotoPageNumber() < 1 {
+		invalid("goto_page_number", pagingOption.GetGotoPageNumber(), "must be greater than 0")
+	}
+	if pagingOption.PageSize != nil && pagingOption.GetPageSize() < 1 {
+		invalid("page_size", pagingOption.GetPageSize(), "must be greater than 0")
+	}
+
+	for i, orderBy := range pagingOption.OrderBy {
+		if orderBy == nil {
+			continue
+		}
+		if strings.TrimSpace(orderBy.Column) == "" {
+			invalid(fmt.Sprintf("order_by[%d].column", i), orderBy.Column, "cannot be empty")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// apply clamp or reject the initialized paging option
+func (limits *PagingLimits) apply(pagingOption *PagingOption) error {
+
+	if limits == nil {
+		return nil
+	}
+
+	var errs ValidationErrors
+	check := func(limit PagingLimit, field, name string, value int64) bool {
+		if limit.Max <= 0 || value <= limit.Max {
+			return true
+		}
+		if limit.Reject {
+			errs = append(errs, &ValidationError{
+				Field:  field,
+				Value:  fmt.Sprint(value),
+				Reason: fmt.Sprintf("%s(%d) must be less than or equal to %d", name, value, limit.Max),
+			})
+		}
+		return false
+	}
+
+	// page size
+	if !check(limits.PageSize, "page_size", "page size", pagingOption.GetPageSize()) && !limits.PageSize.Reject {
+		pagingOption.PageSize = proto.Int64(limits.PageSize.Max)
+	}
+
+	switch {
+
+	case pagingOption.PagingMode == PagingModeCursor && pagingOption.CurrentPageNumber > 0:
+		// jump distance
+		jump := pagingOption.GetGotoPageNumber() - pagingOption.CurrentPageNumber
+		if jump < 0 {
+			jump = -jump
+		}
+		if !check(limits.CursorJump, "goto_page_number", "page jump", jump) && !limits.CursorJump.Reject {
+			jump = limits.CursorJump.Max
+		}
+
+		// offset : next page (jump - 1) * page size ; preceding page jump * page size
+		isPreceding := pagingOption.GetGotoPageNumber() < pagingOption.CurrentPageNumber
+		offset := jump * pagingOption.GetPageSize()
+		if !isPreceding && jump > 0 {
+			offset -= pagingOption.GetPageSize()
+		}
+		if !check(limits.Offset, "goto_page_number", "offset", offset) && !limits.Offset.Reject {
+			jump = limits.Offset.Max / pagingOption.GetPageSize()
+			// keep the jump direction : at least the adjacent page
+			if !isPreceding || jump < 1 {
+				jump++
+			}
+		}
+
+		if isPreceding {
+			pagingOption.GotoPageNumber = proto.Int64(pagingOption.CurrentPageNumber - jump)
+		} else if jump > 0 {
+			pagingOption.GotoPageNumber = proto.Int64(pagingOption.CurrentPageNumber + jump)
+		}
+
+	default: // number mode && the first page of cursor mode
+		// page depth
+		if !check(limits.PageNumber, "goto_page_number", "page number", pagingOption.GetGotoPageNumber()) && !limits.PageNumber.Reject {
+			pagingOption.GotoPageNumber = proto.Int64(limits.PageNumber.Max)
+		}
+
+		// offset
+		offset := (pagingOption.GetGotoPageNumber() - 1) * pagingOption.GetPageSize()
+		if !check(limits.Offset, "goto_page_number", "offset", offset) && !limits.Offset.Reject {
+			pagingOption.GotoPageNumber = proto.Int64(limits.Offset.Max/pagingOption.GetPageSize() + 1)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// checkCursorPage reject the cursor token page over the page number limit (page depth) ,
+// the page of the unsealed token is set by the client
+func (limits *PagingLimits) checkCursorPage(page, pageSize int64) error {
+
+	// show_from && show_to overflow
+	if pageSize > 0 && page > math.MaxInt64/pageSize {
+		return newCursorError(CursorErrorInvalid, "cursor page(%d) out of range", page)
+	}
+
+	if limits == nil || limits.PageNumber.Max <= 0 || page <= limits.PageNumber.Max {
+		return nil
+	}
+	return ValidationErrors{&ValidationError{
+		Field:  "cursor",
+		Value:  fmt.Sprint(page),
+		Reason: fmt.Sprintf("page number(%d) must be less than or equal to %d", page, limits.PageNumber.Max),
+	}}
+}
@@ -0,0 +1,170 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// clamp the paging option to the limits
+func TestPagingLimitsClamp(t *testing.T) {
+	defer func() { DefaultPagingLimits = nil }()
+	DefaultPagingLimits = &PagingLimits{
+		PageSize:   PagingLimit{Max: 100},
+		Offset:     PagingLimit{Max: 1000},
+		CursorJump: PagingLimit{Max: 2},
+	}
+
+	// page size && offset
+	option := DefaultPagingOption()
+	option.PageSize = proto.Int64(10000000)
+	option.GotoPageNumber = proto.Int64(500)
+
+	if err := InitPagingOption(option); err != nil {
+		t.Errorf("\n testing : InitPagingOption error : %v \n", err)
+		return
+	}
+	if option.GetPageSize() != 100 || option.GetGotoPageNumber() != 11 {
+		t.Errorf("\n testing : InitPagingOption clamp error : %+v \n", option)
+	}
+
+	// cursor jump
+	option = DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CurrentPageNumber = 3
+	option.GotoPageNumber = proto.Int64(30)
+
+	if err := InitPagingOption(option); err != nil || option.GetGotoPageNumber() != 5 {
+		t.Errorf("\n testing : InitPagingOption cursor jump error : %v %+v \n", err, option)
+	}
+
+	// cursor mode : the first page && the preceding page clamp to the offset
+	option = DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(10)
+	option.GotoPageNumber = proto.Int64(1000000)
+
+	collection, err := GetOptionCollection(option)
+	if err != nil || option.GetGotoPageNumber() != 101 || collection.Offset != 1000 {
+		t.Errorf("\n testing : GetOptionCollection cursor first page error : %v %+v \n", err, collection)
+	}
+
+	DefaultPagingLimits.CursorJump = PagingLimit{}
+	option = DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(10)
+	option.CurrentPageNumber = 500
+	option.GotoPageNumber = proto.Int64(1)
+
+	if err := InitPagingOption(option); err != nil || option.GetGotoPageNumber() != 400 {
+		t.Errorf("\n testing : InitPagingOption cursor preceding page error : %v %+v \n", err, option)
+	}
+
+	// cursor mode : the offset limit less than the page size , the preceding page is still the preceding page
+	DefaultPagingLimits.Offset = PagingLimit{Max: 5}
+	option = DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(10)
+	option.CurrentPageNumber = 5
+	option.GotoPageNumber = proto.Int64(4)
+	option.CursorValue = 50
+
+	collection, err = GetOptionCollection(option)
+	if err != nil || option.GetGotoPageNumber() != 4 || !collection.IsReverse || collection.Where[0].Symbol != ">=" {
+		t.Errorf("\n testing : GetOptionCollection cursor small offset preceding page error : %v %+v \n", err, collection)
+	}
+}
+
+// reject the paging option over the limits
+func TestPagingLimitsReject(t *testing.T) {
+	defer func() { DefaultPagingLimits = nil }()
+	DefaultPagingLimits = &PagingLimits{
+		PageSize:   PagingLimit{Max: 100, Reject: true},
+		PageNumber: PagingLimit{Max: 50, Reject: true},
+	}
+
+	option := DefaultPagingOption()
+	option.PageSize = proto.Int64(101)
+	option.GotoPageNumber = proto.Int64(51)
+
+	_, err := GetOptionCollection(option)
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 2 || errs[0].Field != "page_size" || errs[1].Field != "goto_page_number" {
+		t.Errorf("\n testing : GetOptionCollection should fail with ValidationErrors : %v \n", err)
+	}
+
+	// cursor mode : the first page (no current page) jump to the large page number
+	DefaultPagingLimits.Offset = PagingLimit{Max: 1000, Reject: true}
+	option = DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.GotoPageNumber = proto.Int64(1000000)
+
+	collection, err := GetOptionCollection(option)
+	if !errors.As(err, &errs) || len(errs) != 2 || errs[0].Field != "goto_page_number" || errs[1].Field != "goto_page_number" {
+		t.Errorf("\n testing : GetOptionCollection cursor mode should fail with ValidationErrors : %v %+v \n", err, collection)
+	}
+
+	// cursor mode : the next page over the offset limit
+	option = DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CurrentPageNumber = 10
+	option.GotoPageNumber = proto.Int64(1000)
+
+	if _, err = GetOptionCollection(option); !errors.As(err, &errs) || len(errs) != 1 || errs[0].Reason != "offset(14835) must be less than or equal to 1000" {
+		t.Errorf("\n testing : GetOptionCollection cursor offset should fail with ValidationErrors : %v \n", err)
+	}
+}
+
+// strict mode : field-level errors instead of the default values
+func TestStrictMode(t *testing.T) {
+	option := &PagingOption{
+		PagingMode:      3,
+		PageSize:        proto.Int64(-1),
+		CursorDirection: "up",
+		OrderBy:         []*PagingOrder{{Column: "", Direction: "asc"}},
+	}
+
+	// not strict : rewrite to the default values , the legacy direction is case-insensitive
+	notStrict := proto.Clone(option).(*PagingOption)
+	notStrict.PagingMode, notStrict.CursorDirection = 0, "ASC"
+	if err := InitPagingOption(notStrict); err != nil || notStrict.GetPageSize() != defaultPageSize || notStrict.PagingMode != PagingModeNumber ||
+		notStrict.CursorDirection != "asc" || notStrict.CursorSortDirection != SortDirection_SORT_DIRECTION_ASC {
+		t.Errorf("\n testing : InitPagingOption error : %v \n", err)
+	}
+
+	// not strict : the unknown paging mode && direction are invalid
+	var errs ValidationErrors
+	if err := InitPagingOption(proto.Clone(option).(*PagingOption)); !errors.As(err, &errs) || len(errs) != 2 {
+		t.Errorf("\n testing : InitPagingOption unknown enums error : %v \n", err)
+	}
+
+	defer func() { DefaultStrictMode = false }()
+	DefaultStrictMode = true
+
+	err := InitPagingOption(option)
+
+	if !errors.As(err, &errs) || len(errs) != 4 {
+		t.Errorf("\n testing : InitPagingOption should fail with ValidationErrors : %v \n", err)
+		return
+	}
+
+	fields := []string{"paging_mode", "cursor_direction", "page_size", "order_by[0].column"}
+	for i, field := range fields {
+		if errs[i].Field != field {
+			t.Errorf("\n testing : ValidationErrors[%d] error : %v \n", i, errs[i])
+		}
+	}
+
+	// the unset fields use the default values
+	if err = InitPagingOption(&PagingOption{}); err != nil {
+		t.Errorf("\n testing : InitPagingOption empty option error : %v \n", err)
+	}
+
+	// explicit presence : the explicit 0 is invalid
+	err = InitPagingOption(&PagingOption{PageSize: proto.Int64(0), GotoPageNumber: proto.Int64(0)})
+	if !errors.As(err, &errs) || len(errs) != 2 || errs[0].Field != "goto_page_number" || errs[1].Field != "page_size" {
+		t.Errorf("\n testing : InitPagingOption explicit 0 error : %v \n", err)
+	}
+}
@@ -0,0 +1,259 @@
+package pagination
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ModelFieldIndex : the field index path of the column in the model struct ,
+// the index path is used by reflect.Value.FieldByIndex
+//
+// the column is resolved in order :
+//
+//		1. the column name of the struct tag : paging:"created_at" > db:"created_at" > gorm:"column:created_at" > json:"created_at"
+//		2. the field name : StringToCamel(column) , or case-insensitive without underscore (user_id => UserID)
+//
+// the fields of the embedded struct , the anonymous struct field and the gorm:"embedded" field are resolved too ,
+// a field of the outer struct is preferred ; the table prefix of the column is ignored (t.created_at => created_at)
+//
+// the resolved index path is cached per model type && column (concurrency safe) ,
+// only the tag column and the camel name of the field are cached (not the case-insensitive variants) ,
+// the index path is shared , do not modify it
+func ModelFieldIndex(modelType reflect.Type, column string) ([]int, error) {
+
+	for modelType != nil && modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+	if modelType == nil {
+		return nil, fmt.Errorf("model isnot struct")
+	}
+
+	// table prefix
+	column = getModelColumn(column)
+
+	key := modelFieldKey{modelType: modelType, column: column}
+	if index, ok := modelFieldCache.Load(key); ok {
+		return index.([]int), nil
+	}
+
+	index, exact, err := resolveModelFieldIndex(modelType, column)
+	if err != nil {
+		return nil, err
+	}
+
+	// the column comes from the client , the cache key is the column without the table prefix ,
+	// and the case-insensitive variants (USER_ID , u_serid ...) are not cached , so the cache is bounded by the fields
+	if exact {
+		modelFieldCache.Store(key, index)
+	}
+	return index, nil
+}
+
+// modelFieldKey cache key of the model field index
+type modelFieldKey struct {
+	modelType reflect.Type
+	column    string
+}
+
+// modelFieldCache modelFieldKey => []int
+var modelFieldCache sync.Map
+
+// getModelColumn the column without the table prefix (t.created_at => created_at)
+func getModelColumn(column string) string {
+
+	column = strings.TrimSpace(column)
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		column = column[i+1:]
+	}
+	return column
+}
+
+// resolveModelFieldIndex the field index path of the column without cache ,
+// exact : the column is the tag column or the camel name of the field
+func resolveModelFieldIndex(modelType reflect.Type, column string) (index []int, exact bool, err error) {
+
+	for modelType != nil && modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+	if modelType == nil || modelType.Kind() != reflect.Struct {
+		return nil, false, fmt.Errorf("model isnot struct")
+	}
+
+	// table prefix
+	column = getModelColumn(column)
+	if column == "" {
+		return nil, false, fmt.Errorf("column cannot be empty")
+	}
+
+	if index = findTagField(modelType, column, ""); index != nil {
+		return index, true, nil
+	}
+	if index = findNameField(modelType, column, true); index != nil {
+		return index, reflect.DeepEqual(index, findNameField(modelType, column, false)), nil
+	}
+	return nil, false, fmt.Errorf("column(%s) cannot be resolved to a field of model(%s) , "+
+		"add a paging:\"%s\" tag to the field", column, modelType.Name(), column)
+}
+
+// findTagField the field of the struct tag column name (breadth first)
+func findTagField(structType reflect.Type, column, prefix string) []int {
+
+	var nested []reflect.StructField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		tagColumn, skip := getTagColumn(field)
+		if skip {
+			continue
+		}
+		if tagColumn != "" && prefix+tagColumn == column && field.PkgPath == "" {
+			return field.Index
+		}
+		if isNestedField(field) {
+			nested = append(nested, field)
+		}
+	}
+
+	for _, field := range nested {
+		if index := findTagField(indirectType(field.Type), column, prefix+getEmbeddedPrefix(field)); index != nil {
+			return append(append([]int{}, field.Index...), index...)
+		}
+	}
+	return nil
+}
+
+// findNameField the field of the field name (breadth first) ,
+// fold : case-insensitive without underscore (user_id => UserID) , otherwise the camel name (user_id => UserId)
+func findNameField(structType reflect.Type, column string, fold bool) []int {
+
+	camelName := StringToCamel(column)
+	foldName := strings.Replace(column, "_", "", -1)
+
+	var nested []reflect.StructField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if _, skip := getTagColumn(field); skip {
+			continue
+		}
+		if isNestedField(field) {
+			nested = append(nested, field)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if field.Name == camelName || (fold && strings.EqualFold(field.Name, foldName)) {
+			return field.Index
+		}
+	}
+
+	for _, field := range nested {
+		prefix := getEmbeddedPrefix(field)
+		if !strings.HasPrefix(column, prefix) {
+			continue
+		}
+		if index := findNameField(indirectType(field.Type), column[len(prefix):], fold); index != nil {
+			return append(append([]int{}, field.Index...), index...)
+		}
+	}
+	return nil
+}
+
+// getTagColumn the column name of the struct tag : paging > db > gorm > json ; skip the field with tag "-" (except json)
+func getTagColumn(field reflect.StructField) (column string, skip bool) {
+
+	for _, key := range []string{"paging", "db", "gorm", "json"} {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+
+		// gorm:"column:created_at;type:datetime"
+		if key == "gorm" {
+			if tag == "-" {
+				return "", true
+			}
+			for _, setting := range strings.Split(tag, ";") {
+				if kv := strings.SplitN(setting, ":", 2); len(kv) == 2 && strings.EqualFold(strings.TrimSpace(kv[0]), "column") {
+					return strings.TrimSpace(kv[1]), false
+				}
+			}
+			continue
+		}
+
+		// json:"-" only hide the field from the json
+		name := strings.TrimSpace(strings.Split(tag, ",")[0])
+		if name == "-" && key == "json" {
+			continue
+		}
+		if name == "-" {
+			return "", true
+		}
+		if name != "" {
+			return name, false
+		}
+	}
+	return "", false
+}
+
+// isNestedField embedded struct , anonymous struct or gorm:"embedded" field
+func isNestedField(field reflect.StructField) bool {
+
+	fieldType := indirectType(field.Type)
+	if fieldType.Kind() != reflect.Struct {
+		return false
+	}
+	if field.Anonymous || fieldType.Name() == "" {
+		return true
+	}
+	return hasGormSetting(field, "embedded")
+}
+
+// getEmbeddedPrefix gorm:"embedded;embeddedPrefix:author_"
+func getEmbeddedPrefix(field reflect.StructField) string {
+
+	for _, setting := range strings.Split(field.Tag.Get("gorm"), ";") {
+		if kv := strings.SplitN(setting, ":", 2); len(kv) == 2 && strings.EqualFold(strings.TrimSpace(kv[0]), "embeddedPrefix") {
+			return strings.TrimSpace(kv[1])
+		}
+	}
+	return ""
+}
+
+// hasGormSetting gorm tag has the setting (example : embedded)
+func hasGormSetting(field reflect.StructField, name string) bool {
+
+	for _, setting := range strings.Split(field.Tag.Get("gorm"), ";") {
+		if strings.EqualFold(strings.TrimSpace(setting), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// indirectType the element type of the pointer
+func indirectType(t reflect.Type) reflect.Type {
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// getModelField the field value of the column , error if the embedded struct pointer is nil
+func getModelField(modelValue reflect.Value, column string) (reflect.Value, error) {
+
+	index, err := ModelFieldIndex(modelValue.Type(), column)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	fieldValue, err := modelValue.FieldByIndexErr(index)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("column(%s) field value fail : %v", column, err)
+	}
+	return fieldValue, nil
+}
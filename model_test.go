@@ -0,0 +1,175 @@
+package pagination
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testModelBase struct {
+	UserID    int64
+	CreatedAt time.Time `db:"create_time"`
+}
+
+type testModelAuthor struct {
+	Name string
+}
+
+type testModel struct {
+	*testModelBase
+	Serial  int64  `paging:"sn" db:"serial_no"`
+	Version int64  `gorm:"column:ver;not null"`
+	Title   string `json:"headline,omitempty"`
+	Secret  string `db:"-"`
+	Meta    struct {
+		Score float64 `db:"score"`
+	}
+	Author testModelAuthor `gorm:"embedded;embeddedPrefix:author_"`
+}
+
+// struct tag && embedded struct column
+func TestModelFieldIndex(t *testing.T) {
+	modelType := reflect.TypeOf(&testModel{})
+
+	tests := []struct {
+		column string
+		want   []int
+	}{
+		{column: "user_id", want: []int{0, 0}},
+		{column: "create_time", want: []int{0, 1}},
+		{column: "sn", want: []int{1}},
+		{column: "ver", want: []int{2}},
+		{column: "headline", want: []int{3}},
+		{column: "t.headline", want: []int{3}},
+		{column: "score", want: []int{5, 0}},
+		{column: "author_name", want: []int{6, 0}},
+	}
+	for _, test := range tests {
+		got, err := ModelFieldIndex(modelType, test.column)
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("\n testing : ModelFieldIndex(%s) error : %v %v \n", test.column, got, err)
+		}
+	}
+
+	// unresolvable column
+	for _, column := range []string{"serial_no", "secret", "unknown"} {
+		if _, err := ModelFieldIndex(modelType, column); err == nil {
+			t.Errorf("\n testing : ModelFieldIndex(%s) should fail \n", column)
+		}
+	}
+}
+
+// cursor value of the embedded struct field
+func TestEmbeddedCursorValue(t *testing.T) {
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CursorColumns = []*PagingOrder{{Column: "create_time", Direction: "desc"}, {Column: "user_id", Direction: "desc"}}
+
+	collection, err := GetOptionCollection(option, &testModel{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	createdAt := time.Date(2021, 2, 18, 8, 0, 0, 0, time.UTC)
+	values, err := DefaultCursorValuesHandler(collection, &testModel{testModelBase: &testModelBase{UserID: 3, CreatedAt: createdAt}})
+	if err != nil || values[0].GetTimeValue() != "2021-02-18T08:00:00Z" || values[1].GetIntValue() != 3 {
+		t.Errorf("\n testing : DefaultCursorValuesHandler error : %v %v \n", err, values)
+	}
+
+	// nil embedded struct pointer
+	if _, err = DefaultCursorValuesHandler(collection, &testModel{}); err == nil {
+		t.Errorf("\n testing : DefaultCursorValuesHandler should fail with nil embedded struct \n")
+	}
+
+	// unknown cursor column
+	option.CursorColumns = []*PagingOrder{{Column: "unknown"}}
+	if _, err = GetOptionCollection(option, &testModel{}); err == nil {
+		t.Errorf("\n testing : GetOptionCollection should fail with unknown cursor column \n")
+	}
+}
+
+// cached index path from concurrent goroutines
+func TestModelFieldIndexCache(t *testing.T) {
+	modelType := reflect.TypeOf(testModel{})
+
+	done := make(chan []int)
+	for i := 0; i < 8; i++ {
+		go func() {
+			index, _ := ModelFieldIndex(modelType, "create_time")
+			done <- index
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		if index := <-done; !reflect.DeepEqual(index, []int{0, 1}) {
+			t.Errorf("\n testing : ModelFieldIndex cache error : %v \n", index)
+		}
+	}
+
+	if _, ok := modelFieldCache.Load(modelFieldKey{modelType: modelType, column: "create_time"}); !ok {
+		t.Errorf("\n testing : ModelFieldIndex should cache the index path \n")
+	}
+
+	// unresolvable column is not cached
+	_, _ = ModelFieldIndex(modelType, "unknown")
+	if _, ok := modelFieldCache.Load(modelFieldKey{modelType: modelType, column: "unknown"}); ok {
+		t.Errorf("\n testing : ModelFieldIndex should not cache the unresolvable column \n")
+	}
+
+	// the table prefix is not a part of the cache key , the case-insensitive variant is not cached
+	for _, column := range []string{"a.create_time", "b.create_time", "USER_ID", "u_ser_id"} {
+		if _, err := ModelFieldIndex(modelType, column); err != nil {
+			t.Errorf("\n testing : ModelFieldIndex(%s) error : %v \n", column, err)
+		}
+	}
+	for _, column := range []string{"a.create_time", "b.create_time", "USER_ID", "u_ser_id"} {
+		if _, ok := modelFieldCache.Load(modelFieldKey{modelType: modelType, column: column}); ok {
+			t.Errorf("\n testing : ModelFieldIndex should not cache the column(%s) \n", column)
+		}
+	}
+}
+
+// go test -run=NONE -bench=. -benchmem
+func BenchmarkFieldByName(b *testing.B) {
+	modelValue := reflect.ValueOf(testModel{Serial: 1})
+	for i := 0; i < b.N; i++ {
+		_ = modelValue.FieldByName(StringToCamel("serial"))
+	}
+}
+
+func BenchmarkModelFieldIndexUncached(b *testing.B) {
+	modelType := reflect.TypeOf(testModel{})
+	for i := 0; i < b.N; i++ {
+		_, _, _ = resolveModelFieldIndex(modelType, "create_time")
+	}
+}
+
+func BenchmarkModelFieldIndex(b *testing.B) {
+	modelType := reflect.TypeOf(testModel{})
+	for i := 0; i < b.N; i++ {
+		_, _ = ModelFieldIndex(modelType, "create_time")
+	}
+}
+
+func BenchmarkSetPagingResult(b *testing.B) {
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CursorColumns = []*PagingOrder{{Column: "create_time", Direction: "desc"}, {Column: "sn", Direction: "desc"}}
+
+	collection, err := GetOptionCollection(option, &testModel{})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	rows := make([]*testModel, 15)
+	for i := range rows {
+		rows[i] = &testModel{testModelBase: &testModelBase{CreatedAt: time.Now()}, Serial: int64(i)}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := SetPagingResult(collection, &PagingResultCollection{TotalRecords: 100, ResultSlice: rows}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
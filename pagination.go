@@ -5,12 +5,20 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // pagination mode
 const (
-	PagingModeNumber int64 = 1 // pagination mode : number
-	PagingModeCursor int64 = 2 // pagination mode : cursor
+	PagingModeNumber = PagingMode_PAGING_MODE_NUMBER // pagination mode : number
+	PagingModeCursor = PagingMode_PAGING_MODE_CURSOR // pagination mode : cursor
+)
+
+// where logic : join PagingWhere.Conditions
+const (
+	PagingWhereAnd = "AND" // where logic : and
+	PagingWhereOr  = "OR"  // where logic : or
 )
 
 const (
@@ -25,35 +33,53 @@ const (
 	defaultOrderDesc                 = "desc" // order direction : desc
 )
 
+// DefaultPeekMode : fetch PageSize + 1 records to detect the next page (PagingOptionCollection.Limit) ,
+// the total records is optional, and LastPage is 0 without the total records (default : false)
+var DefaultPeekMode bool
+
+// DefaultRowCursors : SetPagingResult return the cursor token of every record (PagingResult.RowCursors) ,
+// the client can resume from any record (default : false)
+var DefaultRowCursors bool
+
 // DefaultPagingOption : default paging option
 func DefaultPagingOption() *PagingOption {
-	return &PagingOption{
-		PagingMode:        PagingModeNumber,
-		CurrentPageNumber: defaultCurrentPageNumber,
-		GotoPageNumber:    defaultGotoPageNumber,
-		PageSize:          defaultPageSize,
-		OrderBy:           []*PagingOrder{},
-		CursorColumn:      defaultOrderColumn,
-		CursorDirection:   defaultOrderDirection,
-		CursorValue:       defaultCursorValue,
-	}
+	return defaultPaginator().DefaultPagingOption()
 }
 
 // InitPagingOption : init paging option
 func InitPagingOption(pagingOption *PagingOption) error {
+	return defaultPaginator().InitPagingOption(pagingOption)
+}
+
+// InitPagingOption : init paging option
+func (paginator *Paginator) InitPagingOption(pagingOption *PagingOption) error {
 	// nil pointer
 	if pagingOption == nil {
 		return fmt.Errorf("PagingOption cannot be a nil pointer")
 	}
 
 	// init
-	initPagingOption(pagingOption)
-
-	return nil
+	return paginator.initPagingOption(pagingOption)
 }
 
 // init paging option
-func initPagingOption(pagingOption *PagingOption) {
+//
+// strict mode (WithStrictMode) : return ValidationErrors of the invalid fields ;
+// enums : return ValidationErrors of the unknown paging mode && direction ;
+// limits (WithLimits) : clamp or reject page size, page number, offset and cursor jump
+func (paginator *Paginator) initPagingOption(pagingOption *PagingOption) error {
+	// strict mode
+	if paginator.strictMode {
+		if err := checkPagingOption(pagingOption); err != nil {
+			return err
+		}
+	}
+
+	// enums : the unknown paging mode && direction are invalid , the legacy direction is case-insensitive
+	if errs := checkPagingEnums(pagingOption); len(errs) > 0 {
+		return errs
+	}
+
 	// paging mode
 	pagingOption.PagingMode = getPagingMode(pagingOption.PagingMode)
 
@@ -61,25 +87,39 @@ func initPagingOption(pagingOption *PagingOption) {
 	pagingOption.CurrentPageNumber = getCurrentPageNumber(pagingOption.CurrentPageNumber)
 
 	// which page
-	pagingOption.GotoPageNumber = getGotoPageNumber(pagingOption.GotoPageNumber)
+	pagingOption.GotoPageNumber = proto.Int64(getGotoPageNumber(pagingOption.GetGotoPageNumber()))
 
 	// page size
-	pagingOption.PageSize = getPageSize(pagingOption.PageSize)
+	pagingOption.PageSize = proto.Int64(paginator.getPageSize(pagingOption.GetPageSize()))
 
 	// cursor column
-	pagingOption.CursorColumn = getOrderColumn(pagingOption.CursorColumn)
+	pagingOption.CursorColumn = paginator.getOrderColumn(pagingOption.CursorColumn)
 
 	// cursor direction : asc or desc
-	pagingOption.CursorDirection = getOrderDirection(pagingOption.CursorDirection)
+	pagingOption.CursorDirection = paginator.getOrderDirection(pagingOption.CursorDirection)
+	pagingOption.CursorSortDirection, _ = ParseSortDirection(pagingOption.CursorDirection)
+
+	// multi column cursor
+	for i := range pagingOption.CursorColumns {
+		if pagingOption.CursorColumns[i] == nil {
+			pagingOption.CursorColumns[i] = &PagingOrder{}
+		}
+		pagingOption.CursorColumns[i].Column = paginator.getOrderColumn(pagingOption.CursorColumns[i].Column)
+		pagingOption.CursorColumns[i].Direction = paginator.getOrderDirection(pagingOption.CursorColumns[i].Direction)
+		pagingOption.CursorColumns[i].SortDirection, _ = ParseSortDirection(pagingOption.CursorColumns[i].Direction)
+	}
 
 	// order by
 	//if pagingOption.OrderBy == nil {
 	//	pagingOption.OrderBy = []*PagingOrder{}
 	//}
+
+	// limits
+	return paginator.limits.apply(pagingOption)
 }
 
 // getPagingMode paging mode
-func getPagingMode(pagingMode int64) int64 {
+func getPagingMode(pagingMode PagingMode) PagingMode {
 
 	switch pagingMode {
 
@@ -109,15 +149,6 @@ func getGotoPageNumber(gotoPageNumber int64) int64 {
 	return gotoPageNumber
 }
 
-// getPageSize paging size
-func getPageSize(pageSize int64) int64 {
-
-	if pageSize < 1 {
-		return defaultPageSize
-	}
-	return pageSize
-}
-
 // getOrderColumn paging order column
 func getOrderColumn(column string) string {
 	column = strings.TrimSpace(column)
@@ -143,11 +174,35 @@ func getOrderDirection(direction string) string {
 //////////////////////////////////////////////////////////////////////////////////////////
 
 // PagingWhere : paging where (example : where id = ? => where id = 1)
+//
+// a grouped where has Logic and Conditions instead of Column, Symbol, Placeholder and Data
+// (example : multi column cursor => where (created_at < ? OR (created_at = ? AND id < ?)))
 type PagingWhere struct {
-	Column      string      // where column  (default : id)
-	Symbol      string      // where symbol ( (default : =)
-	Placeholder string      // where placeholder  (default : ?)
-	Data        interface{} // where data (default : interface{})
+	Column      string         // where column  (default : id)
+	Symbol      string         // where symbol ( (default : =)
+	Placeholder string         // where placeholder  (default : ?)
+	Data        interface{}    // where data (default : interface{})
+	Logic       string         // grouped where logic : AND or OR
+	Conditions  []*PagingWhere // grouped where conditions
+}
+
+// Expression : where expression and args
+// (example : id < ? => [1] ; (created_at < ? OR (created_at = ? AND id < ?)) => [t, t, 1])
+func (where *PagingWhere) Expression() (string, []interface{}) {
+	// condition
+	if len(where.Conditions) == 0 {
+		return where.Column + " " + where.Symbol + " " + where.Placeholder, []interface{}{where.Data}
+	}
+
+	// grouped conditions
+	var expressions []string
+	var args []interface{}
+	for _, condition := range where.Conditions {
+		expression, conditionArgs := condition.Expression()
+		expressions = append(expressions, expression)
+		args = append(args, conditionArgs...)
+	}
+	return "(" + strings.Join(expressions, " "+where.Logic+" ") + ")", args
 }
 
 // PagingOptionCollection : paging option collection
@@ -158,25 +213,80 @@ type PagingOptionCollection struct {
 	Where     []*PagingWhere // where
 	Order     []*PagingOrder // order
 	IsReverse bool           // cursor mode order by reverse
+	Peek      bool           // peek mode : Limit is PageSize + 1 , the extra record is trimmed by SetPagingResult
+
+	Expressions map[string]bool // the trusted sql expressions of Order && Where columns (SortPolicy.Expressions) , not quoted
+
+	paginator *Paginator // the paginator of the collection
+}
+
+// IsExpression : the Order || Where column is a trusted sql expression (SortPolicy.Expressions) ,
+// the renderer emit it unquoted , and quote the other columns
+func (collection *PagingOptionCollection) IsExpression(column string) bool {
+	return collection != nil && collection.Expressions[column]
+}
+
+// addExpression the trusted sql expression
+func (collection *PagingOptionCollection) addExpression(expression string) {
+	if collection.Expressions == nil {
+		collection.Expressions = make(map[string]bool)
+	}
+	collection.Expressions[expression] = true
 }
 
 // GetOptionCollection : get paging option collection
+// (check the sort keys with DefaultSortPolicy if it is not nil)
 func GetOptionCollection(pagingOption *PagingOption, models ...interface{}) (*PagingOptionCollection, error) {
+	return defaultPaginator().GetOptionCollection(pagingOption, models...)
+}
+
+// GetOptionCollection : get paging option collection
+// (check the sort keys with the sort policy if it is not nil)
+func (paginator *Paginator) GetOptionCollection(pagingOption *PagingOption, models ...interface{}) (*PagingOptionCollection, error) {
+
+	collection, err := paginator.getOptionCollection(pagingOption, models...)
+	if err != nil {
+		return nil, err
+	}
+	collection.paginator = paginator
+
+	// peek mode : fetch an extra record
+	if paginator.peekMode {
+		collection.Peek = true
+		collection.Limit = collection.Option.GetPageSize() + 1
+	}
+
+	// sortable column allowlist
+	if paginator.sortPolicy != nil {
+		if err := paginator.sortPolicy.Apply(collection); err != nil {
+			return nil, err
+		}
+	}
+	return collection, nil
+}
+
+// getOptionCollection get paging option collection
+func (paginator *Paginator) getOptionCollection(pagingOption *PagingOption, models ...interface{}) (*PagingOptionCollection, error) {
 
 	// init paging option
 	if pagingOption == nil {
-		pagingOption = DefaultPagingOption()
-	} else {
-		initPagingOption(pagingOption)
+		pagingOption = paginator.DefaultPagingOption()
+	} else if err := paginator.initPagingOption(pagingOption); err != nil {
+		return nil, err
+	}
+
+	// cursor token
+	if pagingOption.Cursor != "" {
+		return paginator.getCursorTokenOptionCollection(pagingOption, models...)
 	}
 
 	switch pagingOption.PagingMode {
 
 	case PagingModeCursor:
-		return getCursorOptionCollection(pagingOption, models...)
+		return paginator.getCursorOptionCollection(pagingOption, models...)
 
 	default:
-		return getNumberOptionCollection(pagingOption), nil
+		return paginator.getNumberOptionCollection(pagingOption), nil
 	}
 }
 
@@ -226,11 +336,11 @@ func GetOptionCollection(pagingOption *PagingOption, models ...interface{}) (*Pa
 // 			SELECT * FROM tb_goods ORDER BY auto_id DESC LIMIT 10 OFFSET 70
 //
 // getNumberOptionCollection page number mode option collection
-func getNumberOptionCollection(pagingOption *PagingOption) *PagingOptionCollection {
+func (paginator *Paginator) getNumberOptionCollection(pagingOption *PagingOption) *PagingOptionCollection {
 
-	limit := pagingOption.PageSize
-	offset := pagingOption.PageSize * (pagingOption.GotoPageNumber - 1)
-	orderSlice := DefaultPageNumberOrderHandler(pagingOption.OrderBy)
+	limit := pagingOption.GetPageSize()
+	offset := pagingOption.GetPageSize() * (pagingOption.GetGotoPageNumber() - 1)
+	orderSlice := paginator.pageNumberOrderHandler(pagingOption.OrderBy)
 
 	collection := &PagingOptionCollection{
 		Option: pagingOption,
@@ -259,40 +369,245 @@ var DefaultPageNumberOrderHandler = func(orderOptions []*PagingOrder) []*PagingO
 //////////////////////////////////////////////////////////////////////////////////////////
 
 // getCursorOptionCollection get cursor mode query option collection
-func getCursorOptionCollection(pagingOption *PagingOption, models ...interface{}) (*PagingOptionCollection, error) {
+func (paginator *Paginator) getCursorOptionCollection(pagingOption *PagingOption, models ...interface{}) (*PagingOptionCollection, error) {
 	// check cursor column
-	if err := DefaultCursorColumnCheckHandler(pagingOption, models...); err != nil {
+	if err := paginator.cursorColumnCheckHandler(pagingOption, models...); err != nil {
 		return nil, err
 	}
-	return DefaultCursorOptionCollectionHandler(pagingOption), nil
+
+	// check multi column cursor values
+	if err := checkCursorValues(pagingOption); err != nil {
+		return nil, err
+	}
+	return paginator.cursorOptionCollectionHandler(pagingOption), nil
+}
+
+// checkCursorValues multi column cursor need a value per cursor column
+func checkCursorValues(pagingOption *PagingOption) error {
+
+	// first page || single column cursor
+	if pagingOption.CurrentPageNumber == 0 || len(pagingOption.CursorColumns) == 0 {
+		return nil
+	}
+
+	if len(pagingOption.CursorTypedValues) > 0 {
+		if len(pagingOption.CursorTypedValues) != len(pagingOption.CursorColumns) {
+			return fmt.Errorf("CursorTypedValues length(%d) not equal to CursorColumns length(%d)",
+				len(pagingOption.CursorTypedValues), len(pagingOption.CursorColumns))
+		}
+		if column, ok := getNullCursorColumn(pagingOption.CursorColumns, pagingOption.CursorTypedValues); ok {
+			return fmt.Errorf("CursorTypedValues of the cursor column(%s) cannot be null", column)
+		}
+		return nil
+	}
+
+	if len(pagingOption.CursorValues) != len(pagingOption.CursorColumns) {
+		return fmt.Errorf("CursorValues length(%d) not equal to CursorColumns length(%d)",
+			len(pagingOption.CursorValues), len(pagingOption.CursorColumns))
+	}
+	return nil
 }
 
-// DefaultCursorColumnCheckHandler : check cursor column
+// getCursorColumns cursor columns : PagingOption.CursorColumns,
+// or PagingOption.CursorColumn and PagingOption.CursorDirection if CursorColumns is empty
+func getCursorColumns(pagingOption *PagingOption) []*PagingOrder {
+
+	if len(pagingOption.CursorColumns) > 0 {
+		return pagingOption.CursorColumns
+	}
+
+	return []*PagingOrder{{
+		Column:    getOrderColumn(pagingOption.CursorColumn),
+		Direction: getOrderDirection(pagingOption.CursorDirection),
+	}}
+}
+
+// getCursorValues cursor values : PagingOption.CursorTypedValues, PagingOption.CursorValues,
+// or PagingOption.CursorValue if CursorColumns is empty
+func getCursorValues(pagingOption *PagingOption) []interface{} {
+
+	if len(pagingOption.CursorTypedValues) > 0 {
+		values := make([]interface{}, 0, len(pagingOption.CursorTypedValues))
+		for _, value := range pagingOption.CursorTypedValues {
+			values = append(values, value.Data())
+		}
+		return values
+	}
+
+	if len(pagingOption.CursorColumns) == 0 {
+		return []interface{}{pagingOption.CursorValue}
+	}
+
+	values := make([]interface{}, 0, len(pagingOption.CursorValues))
+	for _, value := range pagingOption.CursorValues {
+		values = append(values, value)
+	}
+	return values
+}
+
+// getCursorOrder cursor mode order by ;
+// reverse every column direction if isReverse (goto preceding page)
+func getCursorOrder(cursorColumns []*PagingOrder, isReverse bool) []*PagingOrder {
+
+	var queryOrder []*PagingOrder
+
+	for _, cursorColumn := range cursorColumns {
+		direction := cursorColumn.Direction
+		if isReverse {
+			direction = getReverseDirection(direction)
+		}
+		queryOrder = append(queryOrder, &PagingOrder{
+			Column:    cursorColumn.Column,
+			Direction: direction,
+		})
+	}
+	return queryOrder
+}
+
+// getReverseDirection asc => desc ; desc => asc
+func getReverseDirection(direction string) string {
+
+	if direction == defaultOrderAsc {
+		return defaultOrderDesc
+	}
+	return defaultOrderAsc
+}
+
+// getCursorSymbol cursor mode where symbol
+//
+// isAfter : the records after the cursor (asc : > ; desc : <)
+// !isAfter : the records before the cursor (asc : < ; desc : >)
+// isInclusive : include the cursor (asc : <= ; desc : >=)
+func getCursorSymbol(direction string, isAfter, isInclusive bool) string {
+
+	if !isAfter {
+		direction = getReverseDirection(direction)
+	}
+
+	symbol := "<"
+	if direction == defaultOrderAsc {
+		symbol = ">"
+	}
+
+	if isInclusive {
+		symbol += "="
+	}
+	return symbol
+}
+
+// getCursorWhere cursor mode where
+//
+// # example : cursor columns (id desc)
+//
+//		* isAfter
+// 			WHERE id < ?
+//
+//		* !isAfter
+// 			WHERE id > ?
+//
+//		* !isAfter && isInclusive
+// 			WHERE id >= ?
+//
+// # example : cursor columns (created_at desc, id desc)
+//
+//		* isAfter
+// 			WHERE (created_at < ? OR (created_at = ? AND id < ?))
+//
+//		* !isAfter && isInclusive
+// 			WHERE (created_at > ? OR (created_at = ? AND id >= ?))
+func getCursorWhere(cursorColumns []*PagingOrder, cursorValues []interface{}, isAfter, isInclusive bool) *PagingWhere {
+
+	// cursor value
+	valueOf := func(i int) interface{} {
+		if i < len(cursorValues) {
+			return cursorValues[i]
+		}
+		return nil
+	}
+
+	// single column
+	if len(cursorColumns) == 1 {
+		return &PagingWhere{
+			Column:      cursorColumns[0].Column,
+			Symbol:      getCursorSymbol(cursorColumns[0].Direction, isAfter, isInclusive),
+			Placeholder: defaultWherePlaceholder,
+			Data:        valueOf(0),
+		}
+	}
+
+	// multi column : (a < ? OR (a = ? AND b < ?) OR (a = ? AND b = ? AND c < ?))
+	where := &PagingWhere{Logic: PagingWhereOr}
+
+	for i, cursorColumn := range cursorColumns {
+		condition := &PagingWhere{Logic: PagingWhereAnd}
+
+		// equal to the previous columns
+		for j := 0; j < i; j++ {
+			condition.Conditions = append(condition.Conditions, &PagingWhere{
+				Column:      cursorColumns[j].Column,
+				Symbol:      "=",
+				Placeholder: defaultWherePlaceholder,
+				Data:        valueOf(j),
+			})
+		}
+
+		// compare current column
+		condition.Conditions = append(condition.Conditions, &PagingWhere{
+			Column:      cursorColumn.Column,
+			Symbol:      getCursorSymbol(cursorColumn.Direction, isAfter, isInclusive && i == len(cursorColumns)-1),
+			Placeholder: defaultWherePlaceholder,
+			Data:        valueOf(i),
+		})
+
+		// single condition
+		if len(condition.Conditions) == 1 {
+			condition = condition.Conditions[0]
+		}
+		where.Conditions = append(where.Conditions, condition)
+	}
+	return where
+}
+
+// DefaultCursorColumnCheckHandler : check cursor column with DefaultCursorColumnHandler ,
+// the check of a paginator (NewPaginator) uses the cursor column handler of the paginator (WithCursorColumnHandler)
 var DefaultCursorColumnCheckHandler = func(pagingOption *PagingOption, models ...interface{}) error {
+	return checkCursorColumns(DefaultCursorColumnHandler, pagingOption, models...)
+}
+
+// checkCursorColumns check cursor column with the cursor column handler of the paginator
+func (paginator *Paginator) checkCursorColumns(pagingOption *PagingOption, models ...interface{}) error {
+	return checkCursorColumns(paginator.cursorColumnHandler, pagingOption, models...)
+}
+
+// checkCursorColumns check cursor column with the cursor column handler
+func checkCursorColumns(cursorColumnHandler CursorColumnHandler, pagingOption *PagingOption, models ...interface{}) error {
 
 	if len(models) == 0 {
 		return nil
 	}
 
 	// check cursor column
-	exist, err := DefaultCursorColumnHandler(pagingOption, models[0])
+	exist, err := cursorColumnHandler(pagingOption, models[0])
 	if err != nil {
 		return err
 	}
 
-	// not exist
+	// not exist : the handler (WithCursorColumnHandler) return false without the detail error
 	if !exist {
-		return fmt.Errorf("cursorColumn(%s) not exist in model(table)", pagingOption.CursorColumn)
+		var columns []string
+		for _, cursorColumn := range getCursorColumns(pagingOption) {
+			columns = append(columns, cursorColumn.Column)
+		}
+		return fmt.Errorf("cursorColumn(%s) not exist in model(table)", strings.Join(columns, ", "))
 	}
 	return nil
 }
 
-// DefaultCursorColumnHandler : model struct has field(cursor column)
-// return error if s not a struct
+// DefaultCursorColumnHandler : model struct has field(cursor column),
+// every column of multi column cursor must exist
+// return error if s not a struct , or the column cannot be resolved (ModelFieldIndex) , it never return false without error
 var DefaultCursorColumnHandler = func(pagingOption *PagingOption, model interface{}) (bool, error) {
 
-	fieldName := StringToCamel(pagingOption.CursorColumn)
-
 	// reflect.Value
 	modelValue := reflect.ValueOf(model)
 
@@ -304,7 +619,13 @@ var DefaultCursorColumnHandler = func(pagingOption *PagingOption, model interfac
 	if modelValue.Kind() != reflect.Struct {
 		return false, fmt.Errorf("model isnot struct")
 	}
-	return modelValue.FieldByName(fieldName).IsValid(), nil
+
+	for _, cursorColumn := range getCursorColumns(pagingOption) {
+		if _, err := ModelFieldIndex(modelValue.Type(), cursorColumn.Column); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
 }
 
 // DefaultCursorOptionCollectionHandler :
@@ -355,7 +676,7 @@ var DefaultCursorColumnHandler = func(pagingOption *PagingOption, model interfac
 var DefaultCursorOptionCollectionHandler = func(pagingOption *PagingOption) *PagingOptionCollection {
 
 	// init cursor query option collection
-	pageSize := pagingOption.PageSize
+	pageSize := pagingOption.GetPageSize()
 
 	collection := &PagingOptionCollection{
 		Option: pagingOption,
@@ -367,108 +688,46 @@ var DefaultCursorOptionCollectionHandler = func(pagingOption *PagingOption) *Pag
 
 	// jump page
 	currentPage := pagingOption.CurrentPageNumber
-	gotoPage := pagingOption.GotoPageNumber
+	gotoPage := pagingOption.GetGotoPageNumber()
 	jumpNumber := gotoPage - currentPage
 
-	// order by
-	order := &PagingOrder{
-		Column:    getOrderColumn(pagingOption.CursorColumn),
-		Direction: getOrderDirection(pagingOption.CursorDirection),
-	}
+	// cursor columns && cursor values
+	cursorColumns := getCursorColumns(pagingOption)
+	cursorValues := getCursorValues(pagingOption)
 
-	// where
-	where := &PagingWhere{
-		Column:      pagingOption.CursorColumn,
-		Symbol:      "=",
-		Placeholder: defaultWherePlaceholder,
-		Data:        pagingOption.CursorValue,
-	}
-
-	// offset && where.Symbol
-	switch order.Direction {
-
-	case defaultOrderAsc: // asc
-
-		switch {
-
-		case currentPage == 0: // first page
-			// offset
-			collection.Offset = (gotoPage - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-			// order
-			order.Direction = defaultOrderAsc
-			collection.Order = append(collection.Order, order)
-
-		case jumpNumber < 0: // preceding page
-			// where
-			where.Symbol = "<="
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderDesc
-			collection.Order = append(collection.Order, order)
-			collection.IsReverse = true
-			// offset
-			collection.Offset = (-jumpNumber) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-
-		default: // next page || page not change(page not change will be jump to next page)
-			// where
-			where.Symbol = ">"
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderAsc
-			collection.Order = append(collection.Order, order)
-			// offset
-			collection.Offset = (jumpNumber - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
+	// offset && where && order
+	switch {
+
+	case currentPage == 0: // first page
+		// offset
+		collection.Offset = (gotoPage - 1) * pageSize
+		if collection.Offset < 0 {
+			collection.Offset = 0
+		}
+		// order
+		collection.Order = append(collection.Order, getCursorOrder(cursorColumns, false)...)
+
+	case jumpNumber < 0: // preceding page
+		// where : asc(<=) ; desc(>=)
+		collection.Where = append(collection.Where, getCursorWhere(cursorColumns, cursorValues, false, true))
+		// order : reverse
+		collection.Order = append(collection.Order, getCursorOrder(cursorColumns, true)...)
+		collection.IsReverse = true
+		// offset
+		collection.Offset = (-jumpNumber) * pageSize
+		if collection.Offset < 0 {
+			collection.Offset = 0
 		}
 
-	default: // desc
-
-		switch {
-
-		case currentPage == 0: // first page
-			// offset
-			collection.Offset = (gotoPage - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-			// order
-			order.Direction = defaultOrderDesc
-			collection.Order = append(collection.Order, order)
-
-		case jumpNumber < 0: // preceding page
-			// where
-			where.Symbol = ">="
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderAsc
-			collection.Order = append(collection.Order, order)
-			collection.IsReverse = true
-			// offset
-			collection.Offset = (-jumpNumber) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-
-		default: // next page || page not change(page not change will be jump to next page)
-			// where
-			where.Symbol = "<"
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderDesc
-			collection.Order = append(collection.Order, order)
-			// offset
-			collection.Offset = (jumpNumber - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
+	default: // next page || page not change(page not change will be jump to next page)
+		// where : asc(>) ; desc(<)
+		collection.Where = append(collection.Where, getCursorWhere(cursorColumns, cursorValues, true, false))
+		// order
+		collection.Order = append(collection.Order, getCursorOrder(cursorColumns, false)...)
+		// offset
+		collection.Offset = (jumpNumber - 1) * pageSize
+		if collection.Offset < 0 {
+			collection.Offset = 0
 		}
 	}
 	return collection
@@ -521,7 +780,7 @@ var DefaultCursorOptionCollectionHandler = func(pagingOption *PagingOption) *Pag
 var AnotherCursorOptionCollectionHandler = func(pagingOption *PagingOption) *PagingOptionCollection {
 
 	// init cursor query option collection
-	pageSize := pagingOption.PageSize
+	pageSize := pagingOption.GetPageSize()
 
 	collection := &PagingOptionCollection{
 		Option: pagingOption,
@@ -533,106 +792,45 @@ var AnotherCursorOptionCollectionHandler = func(pagingOption *PagingOption) *Pag
 
 	// jump page
 	currentPage := pagingOption.CurrentPageNumber
-	gotoPage := pagingOption.GotoPageNumber
+	gotoPage := pagingOption.GetGotoPageNumber()
 	jumpNumber := gotoPage - currentPage
 
-	// order by
-	order := &PagingOrder{
-		Column:    getOrderColumn(pagingOption.CursorColumn),
-		Direction: getOrderDirection(pagingOption.CursorDirection),
-	}
+	// cursor columns && cursor values
+	cursorColumns := getCursorColumns(pagingOption)
+	cursorValues := getCursorValues(pagingOption)
+
+	// offset && where && order
+	switch {
 
-	// where
-	where := &PagingWhere{
-		Column:      pagingOption.CursorColumn,
-		Symbol:      "=",
-		Placeholder: defaultWherePlaceholder,
-		Data:        pagingOption.CursorValue,
-	}
-
-	// offset && where.Symbol
-	switch order.Direction {
-
-	case defaultOrderAsc: // asc
-
-		switch {
-
-		case currentPage == 0: // first page
-			// offset
-			collection.Offset = (gotoPage - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-			// order
-			order.Direction = defaultOrderAsc
-			collection.Order = append(collection.Order, order)
-
-		case jumpNumber < 0: // preceding page
-			// where
-			where.Symbol = "<="
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderAsc
-			collection.Order = append(collection.Order, order)
-			// offset
-			collection.Offset = (gotoPage - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-
-		default: // next page || page not change(page not change will be jump to next page)
-			// where
-			where.Symbol = ">"
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderAsc
-			collection.Order = append(collection.Order, order)
-			// offset
-			collection.Offset = (jumpNumber - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
+	case currentPage == 0: // first page
+		// offset
+		collection.Offset = (gotoPage - 1) * pageSize
+		if collection.Offset < 0 {
+			collection.Offset = 0
+		}
+		// order
+		collection.Order = append(collection.Order, getCursorOrder(cursorColumns, false)...)
+
+	case jumpNumber < 0: // preceding page
+		// where : asc(<=) ; desc(>=)
+		collection.Where = append(collection.Where, getCursorWhere(cursorColumns, cursorValues, false, true))
+		// order
+		collection.Order = append(collection.Order, getCursorOrder(cursorColumns, false)...)
+		// offset
+		collection.Offset = (gotoPage - 1) * pageSize
+		if collection.Offset < 0 {
+			collection.Offset = 0
 		}
 
-	default: // desc
-
-		switch {
-
-		case currentPage == 0: // first page
-			// offset
-			collection.Offset = (gotoPage - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-			// order
-			order.Direction = defaultOrderDesc
-			collection.Order = append(collection.Order, order)
-
-		case jumpNumber < 0: // preceding page
-			// where
-			where.Symbol = ">="
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderDesc
-			collection.Order = append(collection.Order, order)
-			// offset
-			collection.Offset = (gotoPage - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
-
-		default: // next page || page not change(page not change will be jump to next page)
-			// where
-			where.Symbol = "<"
-			collection.Where = append(collection.Where, where)
-			// order
-			order.Direction = defaultOrderDesc
-			collection.Order = append(collection.Order, order)
-			// offset
-			collection.Offset = (jumpNumber - 1) * pageSize
-			if collection.Offset < 0 {
-				collection.Offset = 0
-			}
+	default: // next page || page not change(page not change will be jump to next page)
+		// where : asc(>) ; desc(<)
+		collection.Where = append(collection.Where, getCursorWhere(cursorColumns, cursorValues, true, false))
+		// order
+		collection.Order = append(collection.Order, getCursorOrder(cursorColumns, false)...)
+		// offset
+		collection.Offset = (jumpNumber - 1) * pageSize
+		if collection.Offset < 0 {
+			collection.Offset = 0
 		}
 	}
 	return collection
@@ -669,69 +867,171 @@ var AnotherCursorOptionCollectionHandler = func(pagingOption *PagingOption) *Pag
 //
 // PagingResultCollection : paging result collection
 type PagingResultCollection struct {
-	TotalRecords int64       // total records
-	ResultSlice  interface{} // slice(example:[]struct{} or []*struct{})
+	TotalRecords     int64       // total records
+	TotalApproximate bool        // the total records is approximate (PagingCount.Approximate)
+	ResultSlice      interface{} // slice(example:[]struct{} or []*struct{})
 }
 
-// SetPagingResult : set paging result
+// SetPagingResult : set paging result (with the paginator of the option collection)
 func SetPagingResult(optionCollection *PagingOptionCollection, resultCollection *PagingResultCollection) (*PagingResult, error) {
+	return optionCollection.getPaginator().SetPagingResult(optionCollection, resultCollection)
+}
+
+// SetPagingResult : set paging result
+func (paginator *Paginator) SetPagingResult(optionCollection *PagingOptionCollection, resultCollection *PagingResultCollection) (*PagingResult, error) {
+
+	// peek mode : trim the extra record
+	hasMore, err := trimPeekResultSlice(optionCollection, resultCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	count := &PagingCount{Total: resultCollection.TotalRecords, Approximate: resultCollection.TotalApproximate}
+	pagingResult, err := paginator.setPagingResult(optionCollection, count, hasMore, func() (*PagingResultInfo, error) {
+		return paginator.calcResultSliceHandler(optionCollection, resultCollection)
+	})
+	if err != nil || !paginator.rowCursors {
+		return pagingResult, err
+	}
+
+	// the cursor token of every record
+	if pagingResult.RowCursors, err = paginator.getRowCursors(optionCollection, pagingResult, resultCollection.ResultSlice); err != nil {
+		return pagingResult, err
+	}
+	return pagingResult, nil
+}
+
+// setPagingResult set paging result with the ResultSlice info of calcResultSlice ,
+// hasMore : peek mode fetch the extra record
+func (paginator *Paginator) setPagingResult(optionCollection *PagingOptionCollection, count *PagingCount, hasMore bool, calcResultSlice func() (*PagingResultInfo, error)) (*PagingResult, error) {
+
+	// total records
+	totalRecords := count.Total
 
 	// paging option
 	pagingOption := optionCollection.Option
 
 	// paging result
 	pagingResult := &PagingResult{
-		PagingMode:      pagingOption.PagingMode,       // paging mode
-		TotalSize:       resultCollection.TotalRecords, // total size
-		PageSize:        pagingOption.PageSize,         // page size
-		CurrentPage:     pagingOption.GotoPageNumber,   // current page
-		ShowFrom:        0,                             // current page show from - to record
-		ShowTo:          0,                             // current page show from - to record
-		LastPage:        0,                             // last page
-		OrderBy:         pagingOption.OrderBy,          // order
-		CursorColumn:    pagingOption.CursorColumn,     // cursor column
-		CursorDirection: pagingOption.CursorDirection,  // cursor direction
-		CursorValue:     0,                             // cursor value
-		Option:          pagingOption,                  // paging option
-	}
-
-	// empty records
-	if resultCollection.TotalRecords <= 0 {
+		PagingMode:          pagingOption.PagingMode,          // paging mode
+		TotalSize:           totalRecords,                     // total size
+		PageSize:            pagingOption.GetPageSize(),       // page size
+		CurrentPage:         pagingOption.GetGotoPageNumber(), // current page
+		ShowFrom:            0,                                // current page show from - to record
+		ShowTo:              0,                                // current page show from - to record
+		LastPage:            0,                                // last page
+		OrderBy:             pagingOption.OrderBy,             // order
+		CursorColumn:        pagingOption.CursorColumn,        // cursor column
+		CursorDirection:     pagingOption.CursorDirection,     // cursor direction
+		CursorSortDirection: pagingOption.CursorSortDirection, // cursor direction
+		CursorValue:         0,                                // cursor value
+		CursorColumns:       pagingOption.CursorColumns,       // multi column cursor
+		CursorValues:        nil,                              // multi column cursor values
+		Option:              pagingOption,                     // paging option
+
+		TotalSizeApproximate: count.Approximate, // the total size is approximate
+	}
+
+	// empty records (the total records is optional in peek mode)
+	if totalRecords <= 0 && !optionCollection.Peek {
 		return pagingResult, nil
 	}
 
 	// last page
-	if pagingResult.TotalSize%pagingOption.PageSize == 0 {
-		pagingResult.LastPage = resultCollection.TotalRecords / pagingOption.PageSize
-	} else {
-		pagingResult.LastPage = resultCollection.TotalRecords/pagingOption.PageSize + 1
+	if totalRecords > 0 {
+		if pagingResult.TotalSize%pagingOption.GetPageSize() == 0 {
+			pagingResult.LastPage = totalRecords / pagingOption.GetPageSize()
+		} else {
+			pagingResult.LastPage = totalRecords/pagingOption.GetPageSize() + 1
+		}
 	}
 
+	// has next page && has preceding page
+	pagingResult.HasNextPage, pagingResult.HasPreviousPage = getPageExistence(optionCollection, pagingResult, hasMore)
+
 	// calc ResultSlice
-	sliceInfo, err := DefaultCalcResultSliceHandler(optionCollection, resultCollection)
+	sliceInfo, err := calcResultSlice()
 	if err != nil {
 		return pagingResult, err
 	}
 
+	// approximate total records : the full page may have the next page
+	if count.Approximate && !optionCollection.Peek && sliceInfo.SliceLen >= pagingOption.GetPageSize() && !optionCollection.IsReverse {
+		pagingResult.HasNextPage = true
+	}
+
 	// CursorValue
 	pagingResult.CursorValue = sliceInfo.CursorValue
+	pagingResult.CursorValues = sliceInfo.CursorValues
+	pagingResult.CursorTypedValues = sliceInfo.CursorTypedValues
 
 	// empty slice
 	if sliceInfo.SliceLen == 0 {
 		return pagingResult, nil
 	}
 
+	// cursor token
+	if err := paginator.setCursorToken(optionCollection, pagingResult, sliceInfo); err != nil {
+		return pagingResult, err
+	}
+
 	// show from - to
-	pagingResult.ShowFrom = (pagingResult.CurrentPage-1)*pagingOption.PageSize + 1
+	pagingResult.ShowFrom = (pagingResult.CurrentPage-1)*pagingOption.GetPageSize() + 1
 	pagingResult.ShowTo = pagingResult.ShowFrom + int64(sliceInfo.SliceLen) - 1
 
 	return pagingResult, nil
 }
 
+// getPageExistence has next page && has preceding page
+//
+// peek mode : the extra record is after the page , or before the page when goto the preceding page of cursor mode
+func getPageExistence(optionCollection *PagingOptionCollection, pagingResult *PagingResult, hasMore bool) (hasNext, hasPrevious bool) {
+
+	// the last page : the backward cursor without values
+	if optionCollection.IsReverse && len(optionCollection.Where) == 0 {
+		if optionCollection.Peek {
+			return false, hasMore
+		}
+		return false, pagingResult.TotalSize > pagingResult.PageSize
+	}
+
+	if !optionCollection.Peek {
+		return pagingResult.CurrentPage < pagingResult.LastPage, pagingResult.CurrentPage > 1
+	}
+
+	if optionCollection.IsReverse {
+		return true, hasMore
+	}
+	return hasMore, pagingResult.CurrentPage > 1
+}
+
+// trimPeekResultSlice peek mode : trim the extra record of the ResultSlice(slice pointer)
+func trimPeekResultSlice(optionCollection *PagingOptionCollection, resultCollection *PagingResultCollection) (bool, error) {
+
+	if !optionCollection.Peek {
+		return false, nil
+	}
+
+	sReflectValue := reflect.ValueOf(resultCollection.ResultSlice)
+	if sReflectValue.Kind() != reflect.Ptr || sReflectValue.IsNil() || sReflectValue.Elem().Kind() != reflect.Slice {
+		return false, fmt.Errorf("ResultSlice must be a slice pointer in peek mode")
+	}
+
+	pageSize := int(optionCollection.Option.GetPageSize())
+	if sReflectValue.Elem().Len() <= pageSize {
+		return false, nil
+	}
+	sReflectValue.Elem().Set(sReflectValue.Elem().Slice(0, pageSize))
+	return true, nil
+}
+
 // PagingResultInfo  calc ResultSlice
 type PagingResultInfo struct {
-	SliceLen    int64
-	CursorValue float64
+	SliceLen               int64
+	CursorValue            float64
+	CursorValues           []float64            // cursor values of the last record
+	CursorTypedValues      []*PagingCursorValue // typed cursor values of the last record
+	FirstCursorTypedValues []*PagingCursorValue // typed cursor values of the first record
 }
 
 // DefaultCalcResultSliceHandler calc ResultSlice
@@ -781,20 +1081,38 @@ var DefaultCalcResultSliceHandler = func(optionCollection *PagingOptionCollectio
 	}
 
 	// CursorValue
-	cursorValue, err := DefaultCursorValueHandler(optionCollection, sReflectValue.Index(sLen - 1).Interface())
+	cursorValuesHandler := optionCollection.getCursorValuesHandler()
+	cursorValues, err := cursorValuesHandler(optionCollection, sReflectValue.Index(sLen - 1).Interface())
 	if err != nil {
 		return nil, err
 	}
-	res.CursorValue = cursorValue
+	res.CursorTypedValues = cursorValues
+
+	// numeric CursorValue
+	for _, cursorValue := range cursorValues {
+		floatValue, _ := cursorValue.Float()
+		res.CursorValues = append(res.CursorValues, floatValue)
+	}
+	if len(res.CursorValues) > 0 {
+		res.CursorValue = res.CursorValues[0]
+	}
+
+	// first record CursorValue
+	firstCursorValues, err := cursorValuesHandler(optionCollection, sReflectValue.Index(0).Interface())
+	if err != nil {
+		return nil, err
+	}
+	res.FirstCursorTypedValues = firstCursorValues
 
 	return res, nil
 }
 
-// DefaultCursorValueHandler : calc PagingResult.CursorValue
-var DefaultCursorValueHandler = func(optionCollection *PagingOptionCollection, modelStruct interface{}) (float64, error) {
+// DefaultCursorValuesHandler : calc PagingResult.CursorTypedValues ,
+// a typed value per cursor column (PagingOption.CursorColumns or PagingOption.CursorColumn)
+var DefaultCursorValuesHandler = func(optionCollection *PagingOptionCollection, modelStruct interface{}) ([]*PagingCursorValue, error) {
 	// not cursor mode
 	if optionCollection.Option.PagingMode != PagingModeCursor {
-		return 0, nil
+		return nil, nil
 	}
 
 	mReflectValue := reflect.ValueOf(modelStruct)
@@ -806,19 +1124,53 @@ var DefaultCursorValueHandler = func(optionCollection *PagingOptionCollection, m
 
 	// not struct
 	if mReflectValue.Kind() != reflect.Struct {
-		return 0, fmt.Errorf("ResultSlice value isnot struct")
+		return nil, fmt.Errorf("ResultSlice value isnot struct")
+	}
+
+	cursorColumns := getCursorColumns(optionCollection.Option)
+	cursorValues := make([]*PagingCursorValue, 0, len(cursorColumns))
+	for _, cursorColumn := range cursorColumns {
+		columnValue, err := getCursorField(mReflectValue, cursorColumn.Column)
+		if err != nil {
+			return nil, err
+		}
+
+		cursorValue, err := NewCursorValue(columnValue.Interface())
+		if err != nil {
+			return nil, err
+		}
+		cursorValues = append(cursorValues, cursorValue)
 	}
+	return cursorValues, nil
+}
+
+// DefaultCursorValueHandler : calc PagingResult.CursorValue (the numeric value of PagingOption.CursorColumn)
+//
+// Deprecated: the paginator does not call it , override DefaultCursorValuesHandler or use WithCursorValuesHandler
+// (CursorValuesHandler : the typed values of the cursor columns)
+var DefaultCursorValueHandler = func(optionCollection *PagingOptionCollection, modelStruct interface{}) (float64, error) {
+	// not cursor mode
+	if optionCollection.Option.PagingMode != PagingModeCursor {
+		return 0, nil
+	}
+
+	mReflectValue := reflect.ValueOf(modelStruct)
 
-	// column name
-	columnName := StringToCamel(optionCollection.Option.CursorColumn)
+	// is pointer struct
+	if mReflectValue.Kind() == reflect.Ptr {
+		mReflectValue = mReflectValue.Elem()
+	}
 
-	// column exist in struct
-	if !mReflectValue.FieldByName(columnName).IsValid() {
-		return 0, fmt.Errorf("CursorColumn not exist in ResultSlice struct")
+	// not struct
+	if mReflectValue.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("ResultSlice value isnot struct")
 	}
 
 	// column value
-	columnValue := mReflectValue.FieldByName(columnName)
+	columnValue, err := getCursorField(mReflectValue, optionCollection.Option.CursorColumn)
+	if err != nil {
+		return 0, err
+	}
 
 	switch columnValue.Kind() {
 
@@ -849,3 +1201,13 @@ var DefaultCursorValueHandler = func(optionCollection *PagingOptionCollection, m
 		return 0, fmt.Errorf("CursorColumn value isnot numeric")
 	}
 }
+
+// getCursorField cursor column field of the model struct (see ModelFieldIndex)
+func getCursorField(mReflectValue reflect.Value, column string) (reflect.Value, error) {
+
+	columnValue, err := getModelField(mReflectValue, column)
+	if err != nil {
+		return columnValue, fmt.Errorf("CursorColumn(%s) not exist in ResultSlice struct : %v", column, err)
+	}
+	return columnValue, nil
+}
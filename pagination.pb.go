@@ -1,280 +1,1199 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.21.12
 // source: pagination.proto
 
-/*
-Package pagination is a generated protocol buffer package.
+package pagination
 
-It is generated from these files:
-	pagination.proto
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
 
-It has these top-level messages:
-	PagingOption
-	PagingOrder
-	PagingResult
-*/
-package pagination
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PagingMode : paging mode (json : the enum name , the legacy number 1 or 2)
+type PagingMode int32
+
+const (
+	PagingMode_PAGING_MODE_UNSPECIFIED PagingMode = 0 // the default paging mode : page number mode
+	PagingMode_PAGING_MODE_NUMBER      PagingMode = 1 // page number mode
+	PagingMode_PAGING_MODE_CURSOR      PagingMode = 2 // cursor mode
+)
+
+// Enum value maps for PagingMode.
+var (
+	PagingMode_name = map[int32]string{
+		0: "PAGING_MODE_UNSPECIFIED",
+		1: "PAGING_MODE_NUMBER",
+		2: "PAGING_MODE_CURSOR",
+	}
+	PagingMode_value = map[string]int32{
+		"PAGING_MODE_UNSPECIFIED": 0,
+		"PAGING_MODE_NUMBER":      1,
+		"PAGING_MODE_CURSOR":      2,
+	}
+)
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
+func (x PagingMode) Enum() *PagingMode {
+	p := new(PagingMode)
+	*p = x
+	return p
+}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
+func (x PagingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+func (PagingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pagination_proto_enumTypes[0].Descriptor()
+}
 
-// paging_option : paging option
+func (PagingMode) Type() protoreflect.EnumType {
+	return &file_pagination_proto_enumTypes[0]
+}
+
+func (x PagingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PagingMode.Descriptor instead.
+func (PagingMode) EnumDescriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{0}
+}
+
+// SortDirection : order direction (the legacy direction string : asc or desc)
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // the default direction (the legacy direction string , or desc)
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1 // asc
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2 // desc
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_pagination_proto_enumTypes[1].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_pagination_proto_enumTypes[1]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{1}
+}
+
+// paging_option : paging option (the validation constraints : package paginationvalidate)
 type PagingOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
 	// paging mode : page number mode andcursor mode
-	PagingMode        int64 `protobuf:"varint,1,opt,name=paging_mode,json=pagingMode" json:"paging_mode,omitempty"`
-	CurrentPageNumber int64 `protobuf:"varint,2,opt,name=current_page_number,json=currentPageNumber" json:"current_page_number,omitempty"`
+	PagingMode        PagingMode `protobuf:"varint,1,opt,name=paging_mode,json=pagingMode,proto3,enum=pagination.PagingMode" json:"paging_mode,omitempty"` // page number mode and cursor mode (default : page number)
+	CurrentPageNumber int64      `protobuf:"varint,2,opt,name=current_page_number,json=currentPageNumber,proto3" json:"current_page_number,omitempty"`     // current page number (default : 0)
 	// page info
-	GotoPageNumber int64 `protobuf:"varint,100,opt,name=goto_page_number,json=gotoPageNumber" json:"goto_page_number,omitempty"`
-	PageSize       int64 `protobuf:"varint,101,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	GotoPageNumber *int64 `protobuf:"varint,100,opt,name=goto_page_number,json=gotoPageNumber,proto3,oneof" json:"goto_page_number,omitempty"` // goto page number : which page (default : 1)
+	PageSize       *int64 `protobuf:"varint,101,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`                     // the number of items to be shown per page (default : 15)
 	// order by
-	OrderBy []*PagingOrder `protobuf:"bytes,200,rep,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	OrderBy []*PagingOrder `protobuf:"bytes,200,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"` // order by (default : id desc)
 	// cursor mode
-	CursorColumn    string  `protobuf:"bytes,300,opt,name=cursor_column,json=cursorColumn" json:"cursor_column,omitempty"`
-	CursorDirection string  `protobuf:"bytes,301,opt,name=cursor_direction,json=cursorDirection" json:"cursor_direction,omitempty"`
-	CursorValue     float64 `protobuf:"fixed64,302,opt,name=cursor_value,json=cursorValue" json:"cursor_value,omitempty"`
+	CursorColumn string `protobuf:"bytes,300,opt,name=cursor_column,json=cursorColumn,proto3" json:"cursor_column,omitempty"` // cursor column (default : id)
+	// Deprecated: Marked as deprecated in pagination.proto.
+	CursorDirection     string               `protobuf:"bytes,301,opt,name=cursor_direction,json=cursorDirection,proto3" json:"cursor_direction,omitempty"`                                              // deprecated : cursor direction : asc or desc (default : desc)
+	CursorValue         float64              `protobuf:"fixed64,302,opt,name=cursor_value,json=cursorValue,proto3" json:"cursor_value,omitempty"`                                                        // cursor value (default : 0)
+	CursorColumns       []*PagingOrder       `protobuf:"bytes,303,rep,name=cursor_columns,json=cursorColumns,proto3" json:"cursor_columns,omitempty"`                                                    // multi column cursor (example : created_at desc, id desc)
+	CursorValues        []float64            `protobuf:"fixed64,304,rep,packed,name=cursor_values,json=cursorValues,proto3" json:"cursor_values,omitempty"`                                              // multi column cursor values, one value per cursor_columns
+	Cursor              string               `protobuf:"bytes,305,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                                                       // opaque cursor token : next_cursor or prev_cursor of paging_result
+	CursorTypedValues   []*PagingCursorValue `protobuf:"bytes,306,rep,name=cursor_typed_values,json=cursorTypedValues,proto3" json:"cursor_typed_values,omitempty"`                                      // typed cursor values, one value per cursor column
+	CursorSortDirection SortDirection        `protobuf:"varint,307,opt,name=cursor_sort_direction,json=cursorSortDirection,proto3,enum=pagination.SortDirection" json:"cursor_sort_direction,omitempty"` // cursor direction , override cursor_direction (default : desc)
 }
 
-func (m *PagingOption) Reset()                    { *m = PagingOption{} }
-func (m *PagingOption) String() string            { return proto.CompactTextString(m) }
-func (*PagingOption) ProtoMessage()               {}
-func (*PagingOption) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (x *PagingOption) Reset() {
+	*x = PagingOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pagination_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (m *PagingOption) GetPagingMode() int64 {
-	if m != nil {
-		return m.PagingMode
+func (x *PagingOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagingOption) ProtoMessage() {}
+
+func (x *PagingOption) ProtoReflect() protoreflect.Message {
+	mi := &file_pagination_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (m *PagingOption) GetCurrentPageNumber() int64 {
-	if m != nil {
-		return m.CurrentPageNumber
+// Deprecated: Use PagingOption.ProtoReflect.Descriptor instead.
+func (*PagingOption) Descriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PagingOption) GetPagingMode() PagingMode {
+	if x != nil {
+		return x.PagingMode
+	}
+	return PagingMode_PAGING_MODE_UNSPECIFIED
+}
+
+func (x *PagingOption) GetCurrentPageNumber() int64 {
+	if x != nil {
+		return x.CurrentPageNumber
 	}
 	return 0
 }
 
-func (m *PagingOption) GetGotoPageNumber() int64 {
-	if m != nil {
-		return m.GotoPageNumber
+func (x *PagingOption) GetGotoPageNumber() int64 {
+	if x != nil && x.GotoPageNumber != nil {
+		return *x.GotoPageNumber
 	}
 	return 0
 }
 
-func (m *PagingOption) GetPageSize() int64 {
-	if m != nil {
-		return m.PageSize
+func (x *PagingOption) GetPageSize() int64 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
 	return 0
 }
 
-func (m *PagingOption) GetOrderBy() []*PagingOrder {
-	if m != nil {
-		return m.OrderBy
+func (x *PagingOption) GetOrderBy() []*PagingOrder {
+	if x != nil {
+		return x.OrderBy
 	}
 	return nil
 }
 
-func (m *PagingOption) GetCursorColumn() string {
-	if m != nil {
-		return m.CursorColumn
+func (x *PagingOption) GetCursorColumn() string {
+	if x != nil {
+		return x.CursorColumn
 	}
 	return ""
 }
 
-func (m *PagingOption) GetCursorDirection() string {
-	if m != nil {
-		return m.CursorDirection
+// Deprecated: Marked as deprecated in pagination.proto.
+func (x *PagingOption) GetCursorDirection() string {
+	if x != nil {
+		return x.CursorDirection
 	}
 	return ""
 }
 
-func (m *PagingOption) GetCursorValue() float64 {
-	if m != nil {
-		return m.CursorValue
+func (x *PagingOption) GetCursorValue() float64 {
+	if x != nil {
+		return x.CursorValue
 	}
 	return 0
 }
 
-// paging_order : paging order (example : order by id desc)
+func (x *PagingOption) GetCursorColumns() []*PagingOrder {
+	if x != nil {
+		return x.CursorColumns
+	}
+	return nil
+}
+
+func (x *PagingOption) GetCursorValues() []float64 {
+	if x != nil {
+		return x.CursorValues
+	}
+	return nil
+}
+
+func (x *PagingOption) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *PagingOption) GetCursorTypedValues() []*PagingCursorValue {
+	if x != nil {
+		return x.CursorTypedValues
+	}
+	return nil
+}
+
+func (x *PagingOption) GetCursorSortDirection() SortDirection {
+	if x != nil {
+		return x.CursorSortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+// paging_order : paging order (example : order by id desc) (the validation constraints : package paginationvalidate)
 type PagingOrder struct {
-	Column    string `protobuf:"bytes,1,opt,name=column" json:"column,omitempty"`
-	Direction string `protobuf:"bytes,2,opt,name=direction" json:"direction,omitempty"`
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Column string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"` // order column (default : id)
+	// Deprecated: Marked as deprecated in pagination.proto.
+	Direction     string        `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`                                                             // deprecated : order direction : asc or desc (default : desc)
+	SortDirection SortDirection `protobuf:"varint,3,opt,name=sort_direction,json=sortDirection,proto3,enum=pagination.SortDirection" json:"sort_direction,omitempty"` // order direction , override direction (default : desc)
 }
 
-func (m *PagingOrder) Reset()                    { *m = PagingOrder{} }
-func (m *PagingOrder) String() string            { return proto.CompactTextString(m) }
-func (*PagingOrder) ProtoMessage()               {}
-func (*PagingOrder) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (x *PagingOrder) Reset() {
+	*x = PagingOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pagination_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (m *PagingOrder) GetColumn() string {
-	if m != nil {
-		return m.Column
+func (x *PagingOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagingOrder) ProtoMessage() {}
+
+func (x *PagingOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_pagination_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagingOrder.ProtoReflect.Descriptor instead.
+func (*PagingOrder) Descriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PagingOrder) GetColumn() string {
+	if x != nil {
+		return x.Column
 	}
 	return ""
 }
 
-func (m *PagingOrder) GetDirection() string {
+// Deprecated: Marked as deprecated in pagination.proto.
+func (x *PagingOrder) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *PagingOrder) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+// paging_cursor_value : typed cursor value
+type PagingCursorValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*PagingCursorValue_IntValue
+	//	*PagingCursorValue_UintValue
+	//	*PagingCursorValue_DoubleValue
+	//	*PagingCursorValue_StringValue
+	//	*PagingCursorValue_TimeValue
+	//	*PagingCursorValue_UuidValue
+	//	*PagingCursorValue_DecimalValue
+	//	*PagingCursorValue_NullValue
+	Value isPagingCursorValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *PagingCursorValue) Reset() {
+	*x = PagingCursorValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pagination_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PagingCursorValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagingCursorValue) ProtoMessage() {}
+
+func (x *PagingCursorValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pagination_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagingCursorValue.ProtoReflect.Descriptor instead.
+func (*PagingCursorValue) Descriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *PagingCursorValue) GetValue() isPagingCursorValue_Value {
 	if m != nil {
-		return m.Direction
+		return m.Value
+	}
+	return nil
+}
+
+func (x *PagingCursorValue) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*PagingCursorValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *PagingCursorValue) GetUintValue() uint64 {
+	if x, ok := x.GetValue().(*PagingCursorValue_UintValue); ok {
+		return x.UintValue
+	}
+	return 0
+}
+
+func (x *PagingCursorValue) GetDoubleValue() float64 {
+	if x, ok := x.GetValue().(*PagingCursorValue_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *PagingCursorValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*PagingCursorValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *PagingCursorValue) GetTimeValue() string {
+	if x, ok := x.GetValue().(*PagingCursorValue_TimeValue); ok {
+		return x.TimeValue
+	}
+	return ""
+}
+
+func (x *PagingCursorValue) GetUuidValue() []byte {
+	if x, ok := x.GetValue().(*PagingCursorValue_UuidValue); ok {
+		return x.UuidValue
+	}
+	return nil
+}
+
+func (x *PagingCursorValue) GetDecimalValue() string {
+	if x, ok := x.GetValue().(*PagingCursorValue_DecimalValue); ok {
+		return x.DecimalValue
 	}
 	return ""
 }
 
+func (x *PagingCursorValue) GetNullValue() bool {
+	if x, ok := x.GetValue().(*PagingCursorValue_NullValue); ok {
+		return x.NullValue
+	}
+	return false
+}
+
+type isPagingCursorValue_Value interface {
+	isPagingCursorValue_Value()
+}
+
+type PagingCursorValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,1,opt,name=int_value,json=intValue,proto3,oneof"` // integer value
+}
+
+type PagingCursorValue_UintValue struct {
+	UintValue uint64 `protobuf:"varint,2,opt,name=uint_value,json=uintValue,proto3,oneof"` // unsigned integer value
+}
+
+type PagingCursorValue_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"` // float value
+}
+
+type PagingCursorValue_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"` // string value
+}
+
+type PagingCursorValue_TimeValue struct {
+	TimeValue string `protobuf:"bytes,5,opt,name=time_value,json=timeValue,proto3,oneof"` // time value (RFC3339Nano)
+}
+
+type PagingCursorValue_UuidValue struct {
+	UuidValue []byte `protobuf:"bytes,6,opt,name=uuid_value,json=uuidValue,proto3,oneof"` // uuid value (16 bytes)
+}
+
+type PagingCursorValue_DecimalValue struct {
+	DecimalValue string `protobuf:"bytes,7,opt,name=decimal_value,json=decimalValue,proto3,oneof"` // decimal value (example : 12.30)
+}
+
+type PagingCursorValue_NullValue struct {
+	NullValue bool `protobuf:"varint,8,opt,name=null_value,json=nullValue,proto3,oneof"` // null value
+}
+
+func (*PagingCursorValue_IntValue) isPagingCursorValue_Value() {}
+
+func (*PagingCursorValue_UintValue) isPagingCursorValue_Value() {}
+
+func (*PagingCursorValue_DoubleValue) isPagingCursorValue_Value() {}
+
+func (*PagingCursorValue_StringValue) isPagingCursorValue_Value() {}
+
+func (*PagingCursorValue_TimeValue) isPagingCursorValue_Value() {}
+
+func (*PagingCursorValue_UuidValue) isPagingCursorValue_Value() {}
+
+func (*PagingCursorValue_DecimalValue) isPagingCursorValue_Value() {}
+
+func (*PagingCursorValue_NullValue) isPagingCursorValue_Value() {}
+
 // paging_result : paging result
 type PagingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
 	// paging mode : page number mode and cursor mode
-	PagingMode int64 `protobuf:"varint,1,opt,name=paging_mode,json=pagingMode" json:"paging_mode,omitempty"`
+	PagingMode PagingMode `protobuf:"varint,1,opt,name=paging_mode,json=pagingMode,proto3,enum=pagination.PagingMode" json:"paging_mode,omitempty"` // paging mode
 	// page info
-	TotalSize   int64 `protobuf:"varint,100,opt,name=total_size,json=totalSize" json:"total_size,omitempty"`
-	PageSize    int64 `protobuf:"varint,101,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
-	CurrentPage int64 `protobuf:"varint,102,opt,name=current_page,json=currentPage" json:"current_page,omitempty"`
-	ShowFrom    int64 `protobuf:"varint,103,opt,name=show_from,json=showFrom" json:"show_from,omitempty"`
-	ShowTo      int64 `protobuf:"varint,104,opt,name=show_to,json=showTo" json:"show_to,omitempty"`
-	LastPage    int64 `protobuf:"varint,105,opt,name=last_page,json=lastPage" json:"last_page,omitempty"`
+	TotalSize            int64 `protobuf:"varint,100,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`                                    // total records number
+	PageSize             int64 `protobuf:"varint,101,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                       // the number of items to be shown per page
+	CurrentPage          int64 `protobuf:"varint,102,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`                              // current page number
+	ShowFrom             int64 `protobuf:"varint,103,opt,name=show_from,json=showFrom,proto3" json:"show_from,omitempty"`                                       // current page show from - to records
+	ShowTo               int64 `protobuf:"varint,104,opt,name=show_to,json=showTo,proto3" json:"show_to,omitempty"`                                             // current page show from - to records
+	LastPage             int64 `protobuf:"varint,105,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`                                       // last page
+	HasNextPage          bool  `protobuf:"varint,106,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`                            // has the next page
+	HasPreviousPage      bool  `protobuf:"varint,107,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`                // has the preceding page
+	TotalSizeApproximate bool  `protobuf:"varint,108,opt,name=total_size_approximate,json=totalSizeApproximate,proto3" json:"total_size_approximate,omitempty"` // the total_size is approximate (estimated or capped count)
 	// order by
-	OrderBy []*PagingOrder `protobuf:"bytes,200,rep,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	OrderBy []*PagingOrder `protobuf:"bytes,200,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"` // order by
 	// cursor mode
-	CursorColumn    string  `protobuf:"bytes,300,opt,name=cursor_column,json=cursorColumn" json:"cursor_column,omitempty"`
-	CursorDirection string  `protobuf:"bytes,301,opt,name=cursor_direction,json=cursorDirection" json:"cursor_direction,omitempty"`
-	CursorValue     float64 `protobuf:"fixed64,302,opt,name=cursor_value,json=cursorValue" json:"cursor_value,omitempty"`
+	CursorColumn string `protobuf:"bytes,300,opt,name=cursor_column,json=cursorColumn,proto3" json:"cursor_column,omitempty"` // cursor column
+	// Deprecated: Marked as deprecated in pagination.proto.
+	CursorDirection     string               `protobuf:"bytes,301,opt,name=cursor_direction,json=cursorDirection,proto3" json:"cursor_direction,omitempty"`                                              // deprecated : cursor direction : asc or desc
+	CursorValue         float64              `protobuf:"fixed64,302,opt,name=cursor_value,json=cursorValue,proto3" json:"cursor_value,omitempty"`                                                        // cursor value
+	CursorColumns       []*PagingOrder       `protobuf:"bytes,303,rep,name=cursor_columns,json=cursorColumns,proto3" json:"cursor_columns,omitempty"`                                                    // multi column cursor
+	CursorValues        []float64            `protobuf:"fixed64,304,rep,packed,name=cursor_values,json=cursorValues,proto3" json:"cursor_values,omitempty"`                                              // multi column cursor values
+	NextCursor          string               `protobuf:"bytes,305,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`                                                             // opaque cursor token of the next page
+	PrevCursor          string               `protobuf:"bytes,306,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`                                                             // opaque cursor token of the preceding page
+	CursorTypedValues   []*PagingCursorValue `protobuf:"bytes,307,rep,name=cursor_typed_values,json=cursorTypedValues,proto3" json:"cursor_typed_values,omitempty"`                                      // typed cursor values
+	StartCursor         string               `protobuf:"bytes,308,opt,name=start_cursor,json=startCursor,proto3" json:"start_cursor,omitempty"`                                                          // opaque cursor token of the first record
+	EndCursor           string               `protobuf:"bytes,309,opt,name=end_cursor,json=endCursor,proto3" json:"end_cursor,omitempty"`                                                                // opaque cursor token of the last record
+	RowCursors          []string             `protobuf:"bytes,310,rep,name=row_cursors,json=rowCursors,proto3" json:"row_cursors,omitempty"`                                                             // opaque cursor token of every record
+	CursorSortDirection SortDirection        `protobuf:"varint,311,opt,name=cursor_sort_direction,json=cursorSortDirection,proto3,enum=pagination.SortDirection" json:"cursor_sort_direction,omitempty"` // cursor direction
 	// paging option
-	Option *PagingOption `protobuf:"bytes,400,opt,name=option" json:"option,omitempty"`
+	Option *PagingOption `protobuf:"bytes,400,opt,name=option,proto3" json:"option,omitempty"` // option
 }
 
-func (m *PagingResult) Reset()                    { *m = PagingResult{} }
-func (m *PagingResult) String() string            { return proto.CompactTextString(m) }
-func (*PagingResult) ProtoMessage()               {}
-func (*PagingResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (x *PagingResult) Reset() {
+	*x = PagingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pagination_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (m *PagingResult) GetPagingMode() int64 {
-	if m != nil {
-		return m.PagingMode
+func (x *PagingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagingResult) ProtoMessage() {}
+
+func (x *PagingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pagination_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (m *PagingResult) GetTotalSize() int64 {
-	if m != nil {
-		return m.TotalSize
+// Deprecated: Use PagingResult.ProtoReflect.Descriptor instead.
+func (*PagingResult) Descriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PagingResult) GetPagingMode() PagingMode {
+	if x != nil {
+		return x.PagingMode
+	}
+	return PagingMode_PAGING_MODE_UNSPECIFIED
+}
+
+func (x *PagingResult) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
 	}
 	return 0
 }
 
-func (m *PagingResult) GetPageSize() int64 {
-	if m != nil {
-		return m.PageSize
+func (x *PagingResult) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-func (m *PagingResult) GetCurrentPage() int64 {
-	if m != nil {
-		return m.CurrentPage
+func (x *PagingResult) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
 	}
 	return 0
 }
 
-func (m *PagingResult) GetShowFrom() int64 {
-	if m != nil {
-		return m.ShowFrom
+func (x *PagingResult) GetShowFrom() int64 {
+	if x != nil {
+		return x.ShowFrom
 	}
 	return 0
 }
 
-func (m *PagingResult) GetShowTo() int64 {
-	if m != nil {
-		return m.ShowTo
+func (x *PagingResult) GetShowTo() int64 {
+	if x != nil {
+		return x.ShowTo
 	}
 	return 0
 }
 
-func (m *PagingResult) GetLastPage() int64 {
-	if m != nil {
-		return m.LastPage
+func (x *PagingResult) GetLastPage() int64 {
+	if x != nil {
+		return x.LastPage
 	}
 	return 0
 }
 
-func (m *PagingResult) GetOrderBy() []*PagingOrder {
-	if m != nil {
-		return m.OrderBy
+func (x *PagingResult) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *PagingResult) GetHasPreviousPage() bool {
+	if x != nil {
+		return x.HasPreviousPage
+	}
+	return false
+}
+
+func (x *PagingResult) GetTotalSizeApproximate() bool {
+	if x != nil {
+		return x.TotalSizeApproximate
+	}
+	return false
+}
+
+func (x *PagingResult) GetOrderBy() []*PagingOrder {
+	if x != nil {
+		return x.OrderBy
 	}
 	return nil
 }
 
-func (m *PagingResult) GetCursorColumn() string {
-	if m != nil {
-		return m.CursorColumn
+func (x *PagingResult) GetCursorColumn() string {
+	if x != nil {
+		return x.CursorColumn
 	}
 	return ""
 }
 
-func (m *PagingResult) GetCursorDirection() string {
-	if m != nil {
-		return m.CursorDirection
+// Deprecated: Marked as deprecated in pagination.proto.
+func (x *PagingResult) GetCursorDirection() string {
+	if x != nil {
+		return x.CursorDirection
 	}
 	return ""
 }
 
-func (m *PagingResult) GetCursorValue() float64 {
-	if m != nil {
-		return m.CursorValue
+func (x *PagingResult) GetCursorValue() float64 {
+	if x != nil {
+		return x.CursorValue
 	}
 	return 0
 }
 
-func (m *PagingResult) GetOption() *PagingOption {
-	if m != nil {
-		return m.Option
+func (x *PagingResult) GetCursorColumns() []*PagingOrder {
+	if x != nil {
+		return x.CursorColumns
+	}
+	return nil
+}
+
+func (x *PagingResult) GetCursorValues() []float64 {
+	if x != nil {
+		return x.CursorValues
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*PagingOption)(nil), "pagination.paging_option")
-	proto.RegisterType((*PagingOrder)(nil), "pagination.paging_order")
-	proto.RegisterType((*PagingResult)(nil), "pagination.paging_result")
-}
-
-func init() { proto.RegisterFile("pagination.proto", fileDescriptor0) }
-
-var fileDescriptor0 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0x40, 0x95, 0x46, 0x4a, 0x9b, 0x49, 0x0b, 0xc5, 0x48, 0x60, 0x04, 0x88, 0x12, 0x71, 0x88,
-	0x38, 0xf4, 0x50, 0xf8, 0x82, 0x52, 0x71, 0x03, 0xa1, 0x80, 0x38, 0x70, 0x89, 0xd2, 0xc4, 0x4d,
-	0x23, 0x25, 0x99, 0xc8, 0x71, 0x40, 0xed, 0x57, 0xf0, 0x21, 0xc0, 0x47, 0x70, 0xe2, 0xce, 0x0f,
-	0xad, 0x3c, 0xf6, 0xb6, 0x59, 0x69, 0xb5, 0x7b, 0xde, 0x93, 0xe5, 0x37, 0x6f, 0xc6, 0xf6, 0x8c,
-	0x0c, 0xf3, 0x36, 0x2d, 0xca, 0x26, 0x55, 0x25, 0x36, 0xcb, 0x56, 0xa2, 0x42, 0x06, 0x67, 0x12,
-	0xfe, 0x1f, 0xc1, 0x8c, 0xb6, 0x45, 0x82, 0xad, 0x26, 0xec, 0x05, 0x04, 0x16, 0xd4, 0x98, 0x0b,
-	0xee, 0x2c, 0x9c, 0xc8, 0x8d, 0x4d, 0x4a, 0xf1, 0x01, 0x73, 0xc1, 0x96, 0xf0, 0x30, 0xeb, 0xa5,
-	0x14, 0x8d, 0x4a, 0xda, 0xb4, 0x10, 0x49, 0xd3, 0xd7, 0x5b, 0x21, 0xf9, 0x88, 0xc4, 0x07, 0x36,
-	0xf4, 0x29, 0x2d, 0xc4, 0x47, 0x0a, 0xb0, 0x08, 0xe6, 0x05, 0x2a, 0xbc, 0x22, 0xe7, 0x24, 0xdf,
-	0xd3, 0x7c, 0x60, 0x3e, 0x05, 0x9f, 0xa4, 0xae, 0x3c, 0x0a, 0x2e, 0x48, 0x99, 0x68, 0xf0, 0xb9,
-	0x3c, 0x0a, 0xf6, 0x16, 0x26, 0x28, 0x73, 0x21, 0x93, 0xed, 0x81, 0xff, 0x73, 0x16, 0x6e, 0x14,
-	0xac, 0xf8, 0x72, 0xf8, 0x36, 0xfb, 0x0a, 0xed, 0xc4, 0x63, 0x5a, 0xd6, 0x07, 0xf6, 0x0a, 0x66,
-	0x59, 0x2f, 0x3b, 0x94, 0x49, 0x86, 0x55, 0x5f, 0x37, 0xfc, 0x97, 0xbe, 0xa7, 0x1f, 0x4f, 0x0d,
-	0x7d, 0x47, 0x90, 0xbd, 0x86, 0xb9, 0xb5, 0xf2, 0x52, 0x8a, 0x4c, 0xd7, 0xe3, 0xbf, 0x8d, 0x78,
-	0xdf, 0x04, 0x36, 0x97, 0x9c, 0x85, 0x60, 0x73, 0x93, 0xef, 0x69, 0xd5, 0x0b, 0xfe, 0x47, 0x7b,
-	0x4e, 0x1c, 0x18, 0xf8, 0x55, 0xb3, 0x70, 0x03, 0xd3, 0xe1, 0x75, 0xd8, 0x23, 0xf0, 0xec, 0xf1,
-	0x0e, 0x15, 0xb5, 0x3b, 0xf6, 0x0c, 0xfc, 0xf3, 0x81, 0xe6, 0xbc, 0x33, 0x08, 0xff, 0xba, 0xa7,
-	0xd9, 0x48, 0xd1, 0xf5, 0x95, 0xba, 0x7d, 0x36, 0xcf, 0x01, 0x14, 0xaa, 0xb4, 0x32, 0x2d, 0x34,
-	0x5d, 0xf6, 0x89, 0x50, 0x0f, 0x6f, 0x6c, 0xf0, 0x4b, 0x7a, 0xd8, 0x69, 0xae, 0x7c, 0x47, 0xf1,
-	0x60, 0x30, 0x50, 0x9d, 0xdf, 0xed, 0xf1, 0x47, 0xb2, 0x93, 0x58, 0xf3, 0xc2, 0xe4, 0x6b, 0xf0,
-	0x5e, 0x62, 0xcd, 0x1e, 0xc3, 0x98, 0x82, 0x0a, 0xf9, 0x9e, 0x42, 0x9e, 0xde, 0x7e, 0x41, 0x9d,
-	0x55, 0xa5, 0x9d, 0xad, 0x5a, 0x9a, 0x2c, 0x0d, 0xa8, 0xe4, 0x1d, 0x1a, 0x2b, 0x5b, 0x81, 0x67,
-	0x3e, 0x09, 0xff, 0xe9, 0x2e, 0x9c, 0x28, 0x58, 0x3d, 0xb9, 0xee, 0xa6, 0x64, 0xc4, 0xd6, 0x5c,
-	0x4f, 0xbf, 0x0d, 0xbe, 0xdb, 0xd6, 0xa3, 0x1f, 0xf8, 0xe6, 0x22, 0x00, 0x00, 0xff, 0xff, 0xf5,
-	0x84, 0x6e, 0x2d, 0x95, 0x03, 0x00, 0x00,
+func (x *PagingResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *PagingResult) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+func (x *PagingResult) GetCursorTypedValues() []*PagingCursorValue {
+	if x != nil {
+		return x.CursorTypedValues
+	}
+	return nil
+}
+
+func (x *PagingResult) GetStartCursor() string {
+	if x != nil {
+		return x.StartCursor
+	}
+	return ""
+}
+
+func (x *PagingResult) GetEndCursor() string {
+	if x != nil {
+		return x.EndCursor
+	}
+	return ""
+}
+
+func (x *PagingResult) GetRowCursors() []string {
+	if x != nil {
+		return x.RowCursors
+	}
+	return nil
+}
+
+func (x *PagingResult) GetCursorSortDirection() SortDirection {
+	if x != nil {
+		return x.CursorSortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *PagingResult) GetOption() *PagingOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+// page_token_request : google aip-158 list request
+type PageTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // the maximum number of items to return (default : 15)
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // opaque page token : next_page_token of page_token_response
+	Filter    string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`                        // filter
+	OrderBy   string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // google aip-132 order by (example : created_at desc, id)
+}
+
+func (x *PageTokenRequest) Reset() {
+	*x = PageTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pagination_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageTokenRequest) ProtoMessage() {}
+
+func (x *PageTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pagination_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageTokenRequest.ProtoReflect.Descriptor instead.
+func (*PageTokenRequest) Descriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PageTokenRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PageTokenRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *PageTokenRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *PageTokenRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// page_token_response : google aip-158 list response
+type PageTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // opaque page token of the next page (empty : the last page)
+	TotalSize     int32  `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // total records number (0 : unknown)
+}
+
+func (x *PageTokenResponse) Reset() {
+	*x = PageTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pagination_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageTokenResponse) ProtoMessage() {}
+
+func (x *PageTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pagination_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageTokenResponse.ProtoReflect.Descriptor instead.
+func (*PageTokenResponse) Descriptor() ([]byte, []int) {
+	return file_pagination_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PageTokenResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PageTokenResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+var File_pagination_proto protoreflect.FileDescriptor
+
+var file_pagination_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbf,
+	0x05, 0x0a, 0x0d, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x37, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x10, 0x67, 0x6f, 0x74,
+	0x6f, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x6f, 0x74, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x2e, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xad, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0xae, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0xaf, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0xb0, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0xb1, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x13, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0xb2, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x4e, 0x0a,
+	0x15, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xb3, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x67, 0x6f, 0x74, 0x6f, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb2, 0x02,
+	0x0a, 0x13, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1f, 0x0a, 0x0a, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x75, 0x75, 0x69, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x25, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09,
+	0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x98, 0x08, 0x0a, 0x0d, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x68, 0x6f, 0x77, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x67, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x68, 0x6f, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x68, 0x6f,
+	0x77, 0x5f, 0x74, 0x6f, 0x18, 0x68, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x77,
+	0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x69, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x6a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0xac, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x2e, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xad, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0xae, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0xaf, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0xb0, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0xb1, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0xb2,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x50, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0xb3, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x11, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0xb4, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0xb5, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e,
+	0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x77, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x18, 0xb6, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x6f, 0x77, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x15, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0xb7, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x53, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01,
+	0x0a, 0x12, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x22, 0x5c, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x2a, 0x59, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x41, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x50, 0x41, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x0d,
+	0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x1a, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x42, 0x2f,
+	0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6b, 0x61,
+	0x69, 0x67, 0x75, 0x61, 0x6e, 0x67, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x3b, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pagination_proto_rawDescOnce sync.Once
+	file_pagination_proto_rawDescData = file_pagination_proto_rawDesc
+)
+
+func file_pagination_proto_rawDescGZIP() []byte {
+	file_pagination_proto_rawDescOnce.Do(func() {
+		file_pagination_proto_rawDescData = protoimpl.X.CompressGZIP(file_pagination_proto_rawDescData)
+	})
+	return file_pagination_proto_rawDescData
+}
+
+var file_pagination_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pagination_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pagination_proto_goTypes = []interface{}{
+	(PagingMode)(0),           // 0: pagination.PagingMode
+	(SortDirection)(0),        // 1: pagination.SortDirection
+	(*PagingOption)(nil),      // 2: pagination.paging_option
+	(*PagingOrder)(nil),       // 3: pagination.paging_order
+	(*PagingCursorValue)(nil), // 4: pagination.paging_cursor_value
+	(*PagingResult)(nil),      // 5: pagination.paging_result
+	(*PageTokenRequest)(nil),  // 6: pagination.page_token_request
+	(*PageTokenResponse)(nil), // 7: pagination.page_token_response
+}
+var file_pagination_proto_depIdxs = []int32{
+	0,  // 0: pagination.paging_option.paging_mode:type_name -> pagination.PagingMode
+	3,  // 1: pagination.paging_option.order_by:type_name -> pagination.paging_order
+	3,  // 2: pagination.paging_option.cursor_columns:type_name -> pagination.paging_order
+	4,  // 3: pagination.paging_option.cursor_typed_values:type_name -> pagination.paging_cursor_value
+	1,  // 4: pagination.paging_option.cursor_sort_direction:type_name -> pagination.SortDirection
+	1,  // 5: pagination.paging_order.sort_direction:type_name -> pagination.SortDirection
+	0,  // 6: pagination.paging_result.paging_mode:type_name -> pagination.PagingMode
+	3,  // 7: pagination.paging_result.order_by:type_name -> pagination.paging_order
+	3,  // 8: pagination.paging_result.cursor_columns:type_name -> pagination.paging_order
+	4,  // 9: pagination.paging_result.cursor_typed_values:type_name -> pagination.paging_cursor_value
+	1,  // 10: pagination.paging_result.cursor_sort_direction:type_name -> pagination.SortDirection
+	2,  // 11: pagination.paging_result.option:type_name -> pagination.paging_option
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_pagination_proto_init() }
+func file_pagination_proto_init() {
+	if File_pagination_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pagination_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagingOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pagination_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagingOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pagination_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagingCursorValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pagination_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pagination_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pagination_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pagination_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pagination_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*PagingCursorValue_IntValue)(nil),
+		(*PagingCursorValue_UintValue)(nil),
+		(*PagingCursorValue_DoubleValue)(nil),
+		(*PagingCursorValue_StringValue)(nil),
+		(*PagingCursorValue_TimeValue)(nil),
+		(*PagingCursorValue_UuidValue)(nil),
+		(*PagingCursorValue_DecimalValue)(nil),
+		(*PagingCursorValue_NullValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pagination_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pagination_proto_goTypes,
+		DependencyIndexes: file_pagination_proto_depIdxs,
+		EnumInfos:         file_pagination_proto_enumTypes,
+		MessageInfos:      file_pagination_proto_msgTypes,
+	}.Build()
+	File_pagination_proto = out.File
+	file_pagination_proto_rawDesc = nil
+	file_pagination_proto_goTypes = nil
+	file_pagination_proto_depIdxs = nil
 }
@@ -1,6 +1,10 @@
 package pagination
 
-import "testing"
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
 
 // paging query option collection
 func TestGetOptionCollection(t *testing.T) {
@@ -66,3 +70,69 @@ func TestCursorColumnExistInModel(t *testing.T) {
 		t.Logf("\n DefaultCursorColumnHandler result : %v\n", got)
 	}
 }
+
+// multi column cursor
+func TestMultiColumnCursor(t *testing.T) {
+	type Model struct {
+		CreatedAt int64
+		Id        int64
+	}
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CurrentPageNumber = 1
+	option.GotoPageNumber = proto.Int64(2)
+	option.CursorColumns = []*PagingOrder{
+		{Column: "created_at", Direction: "desc"},
+		{Column: "id", Direction: "desc"},
+	}
+	option.CursorValues = []float64{100, 7}
+
+	collection, err := GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	wantWhere := "(created_at < ? OR (created_at = ? AND id < ?))"
+	where, args := collection.Where[0].Expression()
+	if where != wantWhere || len(args) != 3 {
+		t.Errorf("\n testing : multi column cursor where error : got %s %v, want %s \n", where, args, wantWhere)
+	}
+
+	// preceding page
+	option.CurrentPageNumber = 3
+	collection, err = GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	wantWhere = "(created_at > ? OR (created_at = ? AND id >= ?))"
+	where, _ = collection.Where[0].Expression()
+	if where != wantWhere || !collection.IsReverse || collection.Order[1].Direction != "asc" {
+		t.Errorf("\n testing : multi column cursor preceding where error : got %s, want %s \n", where, wantWhere)
+	}
+
+	// cursor values of the last row
+	result, err := SetPagingResult(collection, &PagingResultCollection{
+		TotalRecords: 3,
+		ResultSlice:  []*Model{{CreatedAt: 100, Id: 6}, {CreatedAt: 100, Id: 5}, {CreatedAt: 99, Id: 9}},
+	})
+	if err != nil {
+		t.Errorf("\n testing : SetPagingResult error : %v \n", err)
+		return
+	}
+
+	if len(result.CursorValues) != 2 || result.CursorValues[0] != 100 || result.CursorValues[1] != 6 {
+		t.Errorf("\n testing : SetPagingResult CursorValues error : %v \n", result.CursorValues)
+	} else {
+		t.Logf("\n SetPagingResult CursorValues : %v\n", result.CursorValues)
+	}
+
+	// cursor values length
+	option.CursorValues = []float64{100}
+	if _, err = GetOptionCollection(option, &Model{}); err == nil {
+		t.Errorf("\n testing : GetOptionCollection should fail with CursorValues length error \n")
+	}
+}
@@ -0,0 +1,105 @@
+package paginationgorm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pagination "github.com/ikaiguang/go-pagination"
+	"gorm.io/gorm"
+)
+
+// DefaultEstimateHandler : planner estimated count of pagination.NewEstimatedCount
+// (default : PostgresEstimate of postgres, MySQLEstimate of mysql)
+var DefaultEstimateHandler = func(db *gorm.DB) (int64, error) {
+
+	switch db.Dialector.Name() {
+
+	case pagination.DialectPostgres:
+		return PostgresEstimate(db)
+
+	case pagination.DialectMySQL:
+		return MySQLEstimate(db)
+
+	default:
+		return 0, fmt.Errorf("estimated count not supported of the dialect(%s)", db.Dialector.Name())
+	}
+}
+
+// CountQuery : the count queries of the count strategy (pagination.CountStrategy) ,
+// the model is used when the db has no model or table ,
+// the key (the count sql) is built only by the cached count (pagination.NewCachedCount)
+//
+// example :
+//			count, err := collection.Count(ctx, paginationgorm.CountQuery(db.Where("status = ?", 1), &User{}))
+func CountQuery(db *gorm.DB, model interface{}) *pagination.CountQuery {
+
+	baseDB := db.Session(&gorm.Session{})
+	if baseDB.Statement.Model == nil && baseDB.Statement.Table == "" {
+		baseDB = baseDB.Model(model)
+	}
+
+	return &pagination.CountQuery{
+		KeyFunc: func() string {
+			return baseDB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				var total int64
+				return tx.Count(&total)
+			})
+		},
+		Count: func(ctx context.Context) (int64, error) {
+			var total int64
+			err := baseDB.WithContext(ctx).Count(&total).Error
+			return total, err
+		},
+		CountMax: func(ctx context.Context, max int64) (int64, error) {
+			var total int64
+			limitDB := baseDB.Session(&gorm.Session{}).Select("1").Limit(int(max))
+			err := db.WithContext(ctx).Session(&gorm.Session{NewDB: true}).Table("(?) AS paging_c", limitDB).Count(&total).Error
+			return total, err
+		},
+		Estimate: func(ctx context.Context) (int64, error) {
+			return DefaultEstimateHandler(baseDB.WithContext(ctx))
+		},
+	}
+}
+
+// PostgresEstimate : the rows estimated by the postgres planner , EXPLAIN (FORMAT JSON) query
+func PostgresEstimate(db *gorm.DB) (int64, error) {
+
+	statement := db.Session(&gorm.Session{DryRun: true}).Find(&[]map[string]interface{}{}).Statement
+
+	var plan string
+	if err := db.Session(&gorm.Session{NewDB: true}).Raw("EXPLAIN (FORMAT JSON) "+statement.SQL.String(), statement.Vars...).Row().Scan(&plan); err != nil {
+		return 0, err
+	}
+
+	var explain []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explain); err != nil {
+		return 0, fmt.Errorf("postgres explain error : %v", err)
+	}
+	if len(explain) == 0 {
+		return 0, fmt.Errorf("postgres explain error : empty plan")
+	}
+	return int64(explain[0].Plan.Rows), nil
+}
+
+// MySQLEstimate : the table rows estimated by mysql information_schema.TABLES ,
+// the estimate is the rows of the table, not the rows of the query conditions
+func MySQLEstimate(db *gorm.DB) (int64, error) {
+
+	statement := db.Session(&gorm.Session{DryRun: true}).Find(&[]map[string]interface{}{}).Statement
+	if statement.Table == "" {
+		return 0, fmt.Errorf("mysql estimate need the table of the query")
+	}
+
+	var total int64
+	err := db.Session(&gorm.Session{NewDB: true}).Raw(
+		"SELECT COALESCE(TABLE_ROWS, 0) FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?",
+		statement.Table,
+	).Row().Scan(&total)
+	return total, err
+}
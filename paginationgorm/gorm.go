@@ -0,0 +1,200 @@
+// Package paginationgorm apply the pagination.PagingOptionCollection to gorm
+package paginationgorm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	pagination "github.com/ikaiguang/go-pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Paginate : gorm scope of the paging option collection (where, order by, limit, offset)
+//
+// example :
+//			collection, err := pagination.GetOptionCollection(option, &User{})
+//			err = db.Scopes(paginationgorm.Paginate(collection)).Find(&users).Error
+func Paginate(collection *pagination.PagingOptionCollection) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+
+		if collection == nil {
+			_ = db.AddError(fmt.Errorf("PagingOptionCollection cannot be a nil pointer"))
+			return db
+		}
+
+		// where
+		for _, where := range collection.Where {
+			expression, err := whereExpression(collection, where)
+			if err != nil {
+				_ = db.AddError(err)
+				return db
+			}
+			db = db.Where(expression)
+		}
+
+		// order by
+		for _, order := range collection.Order {
+			db = db.Order(clause.OrderByColumn{
+				Column: columnOf(collection, order.Column),
+				Desc:   order.Direction != "asc",
+			})
+		}
+
+		// limit && offset
+		if collection.Limit > 0 {
+			db = db.Limit(int(collection.Limit))
+		}
+		if collection.Offset > 0 {
+			db = db.Offset(int(collection.Offset))
+		}
+		return db
+	}
+}
+
+// Find : count the records (pagination.CountStrategy, not in peek mode), query the page and set the paging result
+//
+// @Param dest must be a slice pointer (example : &[]User{} or &[]*User{})
+//
+// example :
+//			var users []*User
+//			result, err := paginationgorm.Find(db.Where("status = ?", 1), option, &users)
+func Find(db *gorm.DB, pagingOption *pagination.PagingOption, dest interface{}) (*pagination.PagingResult, error) {
+	return FindWith(db, pagination.NewPaginator(), pagingOption, dest)
+}
+
+// FindWith : Find with the settings of the paginator (page size, limits, sort policy, cursor sealer, count strategy ...)
+//
+// example :
+//			paginator := pagination.NewPaginator(pagination.WithPageSize(20))
+//			var users []*User
+//			result, err := paginationgorm.FindWith(db.Where("status = ?", 1), paginator, option, &users)
+func FindWith(db *gorm.DB, paginator *pagination.Paginator, pagingOption *pagination.PagingOption, dest interface{}) (*pagination.PagingResult, error) {
+
+	if paginator == nil {
+		return nil, fmt.Errorf("Paginator cannot be a nil pointer")
+	}
+
+	model, err := sliceModel(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	// paging option collection
+	collection, err := paginator.GetOptionCollection(pagingOption, model)
+	if err != nil {
+		return nil, err
+	}
+
+	// count : the count strategy (pagination.DefaultCountStrategy) , skip in peek mode (pagination.DefaultPeekMode)
+	count, err := collection.Count(db.Statement.Context, CountQuery(db, model))
+	if err != nil {
+		return nil, err
+	}
+
+	// query
+	if count.Total > 0 || collection.Peek {
+		if err := db.Session(&gorm.Session{}).Scopes(Paginate(collection)).Find(dest).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return paginator.SetPagingResult(collection, &pagination.PagingResultCollection{
+		TotalRecords:     count.Total,
+		TotalApproximate: count.Approximate,
+		ResultSlice:      dest,
+	})
+}
+
+// whereExpression paging where => gorm clause expression
+func whereExpression(collection *pagination.PagingOptionCollection, where *pagination.PagingWhere) (clause.Expression, error) {
+
+	if where == nil {
+		return nil, fmt.Errorf("PagingWhere cannot be a nil pointer")
+	}
+
+	// grouped conditions
+	if len(where.Conditions) > 0 {
+		var expressions []clause.Expression
+		for _, condition := range where.Conditions {
+			expression, err := whereExpression(collection, condition)
+			if err != nil {
+				return nil, err
+			}
+			expressions = append(expressions, expression)
+		}
+
+		switch strings.ToUpper(where.Logic) {
+
+		case pagination.PagingWhereAnd:
+			return clause.And(expressions...), nil
+
+		case pagination.PagingWhereOr:
+			return clause.Or(expressions...), nil
+
+		default:
+			return nil, fmt.Errorf("PagingWhere logic(%s) not supported", where.Logic)
+		}
+	}
+
+	// condition
+	column := columnOf(collection, where.Column)
+
+	switch strings.TrimSpace(where.Symbol) {
+
+	case "=":
+		return clause.Eq{Column: column, Value: where.Data}, nil
+
+	case "<>", "!=":
+		return clause.Neq{Column: column, Value: where.Data}, nil
+
+	case "<":
+		return clause.Lt{Column: column, Value: where.Data}, nil
+
+	case "<=":
+		return clause.Lte{Column: column, Value: where.Data}, nil
+
+	case ">":
+		return clause.Gt{Column: column, Value: where.Data}, nil
+
+	case ">=":
+		return clause.Gte{Column: column, Value: where.Data}, nil
+
+	default:
+		return nil, fmt.Errorf("PagingWhere symbol(%s) not supported", where.Symbol)
+	}
+}
+
+// columnOf quoted column (example : t.created_at => `t`.`created_at`) ,
+// or the raw column of the trusted sql expression (SortPolicy.Expressions)
+func columnOf(collection *pagination.PagingOptionCollection, column string) clause.Column {
+
+	if collection.IsExpression(column) {
+		return clause.Column{Name: column, Raw: true}
+	}
+
+	column = strings.TrimSpace(column)
+	if i := strings.LastIndex(column, "."); i > 0 {
+		return clause.Column{Table: column[:i], Name: column[i+1:]}
+	}
+	return clause.Column{Name: column}
+}
+
+// sliceModel a model of the slice pointer element (example : &[]*User{} => &User{})
+func sliceModel(dest interface{}) (interface{}, error) {
+
+	destType := reflect.TypeOf(dest)
+	if destType == nil || destType.Kind() != reflect.Ptr || destType.Elem().Kind() != reflect.Slice {
+		return nil, fmt.Errorf("dest must be a slice pointer")
+	}
+
+	elemType := destType.Elem().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("dest slice element isnot struct")
+	}
+	return reflect.New(elemType).Interface(), nil
+}
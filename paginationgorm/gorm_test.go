@@ -0,0 +1,210 @@
+package paginationgorm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	pagination "github.com/ikaiguang/go-pagination"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type User struct {
+	Id   int64
+	Name string
+	Age  int64
+}
+
+// sqlite memory db with 10 users (id 1 ~ 10)
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("\n testing : gorm.Open error : %v \n", err)
+	}
+	if err = db.AutoMigrate(&User{}); err != nil {
+		t.Fatalf("\n testing : AutoMigrate error : %v \n", err)
+	}
+
+	for i := int64(1); i <= 10; i++ {
+		if err = db.Create(&User{Id: i, Name: "user", Age: 20 + i%3}).Error; err != nil {
+			t.Fatalf("\n testing : Create error : %v \n", err)
+		}
+	}
+	return db
+}
+
+// gorm scope
+func TestPaginate(t *testing.T) {
+	db := newTestDB(t)
+
+	option := pagination.DefaultPagingOption()
+	option.PagingMode = pagination.PagingModeCursor
+	option.PageSize = proto.Int64(3)
+	option.CursorColumns = []*pagination.PagingOrder{{Column: "age", Direction: "desc"}, {Column: "id", Direction: "asc"}}
+	option.CursorTypedValues = []*pagination.PagingCursorValue{
+		{Value: &pagination.PagingCursorValue_IntValue{IntValue: 22}},
+		{Value: &pagination.PagingCursorValue_IntValue{IntValue: 5}},
+	}
+	option.CurrentPageNumber = 1
+	option.GotoPageNumber = proto.Int64(2)
+
+	collection, err := pagination.GetOptionCollection(option, &User{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	var users []*User
+	if err = db.Scopes(Paginate(collection)).Find(&users).Error; err != nil {
+		t.Errorf("\n testing : Paginate error : %v \n", err)
+		return
+	}
+
+	// age desc, id asc : (22,2) (22,5) (22,8) (21,1) (21,4) (21,7) (21,10) ...
+	var ids []int64
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+	if len(ids) != 3 || ids[0] != 8 || ids[1] != 1 || ids[2] != 4 {
+		t.Errorf("\n testing : Paginate error : ids %v \n", ids)
+	}
+}
+
+// the trusted sql expression of the sort policy is not quoted
+func TestPaginateExpression(t *testing.T) {
+	db := newTestDB(t)
+
+	policy := pagination.NewSortPolicy(map[string]string{"id": "id"})
+	policy.Expressions = map[string]string{"age": "(age % 20)"}
+
+	option := pagination.DefaultPagingOption()
+	option.PagingMode = pagination.PagingModeCursor
+	option.PageSize = proto.Int64(3)
+	option.CursorColumns = []*pagination.PagingOrder{{Column: "age", Direction: "desc"}, {Column: "id", Direction: "asc"}}
+	option.CursorTypedValues = []*pagination.PagingCursorValue{
+		{Value: &pagination.PagingCursorValue_IntValue{IntValue: 2}},
+		{Value: &pagination.PagingCursorValue_IntValue{IntValue: 5}},
+	}
+	option.CurrentPageNumber = 1
+	option.GotoPageNumber = proto.Int64(2)
+
+	collection, err := policy.GetOptionCollection(option, &User{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	var users []*User
+	statement := db.Session(&gorm.Session{DryRun: true}).Scopes(Paginate(collection)).Find(&users).Statement
+	if sql := statement.SQL.String(); !strings.Contains(sql, "(age % 20) < ?") || !strings.Contains(sql, "ORDER BY (age % 20) DESC,`id`") {
+		t.Errorf("\n testing : Paginate expression error : %s \n", sql)
+	}
+
+	if err = db.Scopes(Paginate(collection)).Find(&users).Error; err != nil {
+		t.Errorf("\n testing : Paginate error : %v \n", err)
+		return
+	}
+
+	// (age % 20) desc, id asc : (2,2) (2,5) (2,8) (1,1) (1,4) ...
+	var ids []int64
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+	if len(ids) != 3 || ids[0] != 8 || ids[1] != 1 || ids[2] != 4 {
+		t.Errorf("\n testing : Paginate expression error : ids %v \n", ids)
+	}
+}
+
+// count, query && paging result
+func TestFind(t *testing.T) {
+	db := newTestDB(t)
+
+	// number paging
+	option := pagination.DefaultPagingOption()
+	option.PageSize = proto.Int64(4)
+	option.GotoPageNumber = proto.Int64(3)
+	option.OrderBy = []*pagination.PagingOrder{{Column: "id", Direction: "asc"}}
+
+	var users []*User
+	result, err := Find(db, option, &users)
+	if err != nil {
+		t.Errorf("\n testing : Find error : %v \n", err)
+		return
+	}
+	if result.TotalSize != 10 || result.LastPage != 3 || len(users) != 2 || users[0].Id != 9 {
+		t.Errorf("\n testing : Find error : %+v \n", result)
+	}
+
+	// cursor token paging with the base query
+	option = pagination.DefaultPagingOption()
+	option.PagingMode = pagination.PagingModeCursor
+	option.PageSize = proto.Int64(2)
+
+	var pages [][]User
+	for i := 0; i < 2; i++ {
+		var rows []User
+		result, err = Find(db.Where("age = ?", 21), option, &rows)
+		if err != nil {
+			t.Errorf("\n testing : Find cursor error : %v \n", err)
+			return
+		}
+		pages = append(pages, rows)
+
+		next := pagination.DefaultPagingOption()
+		next.PageSize = proto.Int64(2)
+		next.Cursor = result.NextCursor
+		option = next
+	}
+
+	// age = 21 : id 10, 7, 4, 1
+	if result.TotalSize != 4 || len(pages[0]) != 2 || pages[0][0].Id != 10 || pages[1][1].Id != 1 || result.NextCursor != "" {
+		t.Errorf("\n testing : Find cursor error : %+v \n", pages)
+	}
+}
+
+// find with the settings of the paginator
+func TestFindWith(t *testing.T) {
+	db := newTestDB(t)
+
+	if _, err := FindWith(db, nil, pagination.DefaultPagingOption(), &[]User{}); err == nil {
+		t.Errorf("\n testing : FindWith nil paginator error : %v \n", err)
+	}
+
+	// peek mode : no count , page size 3 of the paginator
+	paginator := pagination.NewPaginator(pagination.WithPageSize(3), pagination.WithPeekMode(true))
+	option := paginator.DefaultPagingOption()
+	option.OrderBy = []*pagination.PagingOrder{{Column: "id", Direction: "asc"}}
+
+	var users []*User
+	result, err := FindWith(db, paginator, option, &users)
+	if err != nil {
+		t.Errorf("\n testing : FindWith error : %v \n", err)
+		return
+	}
+	if result.TotalSize != 0 || !result.HasNextPage || len(users) != 3 || users[2].Id != 3 {
+		t.Errorf("\n testing : FindWith error : %+v \n", result)
+	}
+}
+
+// count at most max records
+func TestCountQuery(t *testing.T) {
+	db := newTestDB(t)
+
+	countQuery := CountQuery(db.Where("age = ?", 21), &User{})
+	if countQuery.Key != "" || !strings.Contains(countQuery.KeyFunc(), "age = 21") {
+		t.Errorf("\n testing : CountQuery key error : %s \n", countQuery.KeyFunc())
+	}
+
+	// age 21 : id 1, 4, 7, 10
+	count, err := pagination.NewCappedCount(3).Count(context.Background(), countQuery)
+	if err != nil || count.Total != 3 || !count.Approximate {
+		t.Errorf("\n testing : capped count error : %v %+v \n", err, count)
+	}
+
+	if total, err := countQuery.CountMax(context.Background(), 10); err != nil || total != 4 {
+		t.Errorf("\n testing : CountMax error : %v %d \n", err, total)
+	}
+}
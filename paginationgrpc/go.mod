@@ -0,0 +1,18 @@
+module github.com/ikaiguang/go-pagination/paginationgrpc
+
+go 1.19
+
+require (
+	github.com/ikaiguang/go-pagination v0.0.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240401170217-c3f982113cda
+	google.golang.org/grpc v1.64.1
+	google.golang.org/protobuf v1.33.0
+)
+
+require (
+	golang.org/x/net v0.26.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.20.0 // indirect
+)
+
+replace github.com/ikaiguang/go-pagination => ../
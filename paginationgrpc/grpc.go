@@ -0,0 +1,162 @@
+// Package paginationgrpc normalize the pagination.PagingOption of the grpc requests
+package paginationgrpc
+
+import (
+	"context"
+	"errors"
+
+	pagination "github.com/ikaiguang/go-pagination"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// pagingOptionName the full name of the paging option message
+var pagingOptionName = (&pagination.PagingOption{}).ProtoReflect().Descriptor().FullName()
+
+// InterceptorOption : functional option of UnaryServerInterceptor
+type InterceptorOption func(interceptor *interceptor)
+
+// interceptor the paginators of the methods
+type interceptor struct {
+	paginator *pagination.Paginator            // the paginator of the methods without the method paginator
+	methods   map[string]*pagination.Paginator // full method (example : /user.v1.UserService/ListUsers) => paginator
+}
+
+// WithPaginator : the paginator of the methods without the method paginator (default : the package-level paginator)
+func WithPaginator(paginator *pagination.Paginator) InterceptorOption {
+	return func(interceptor *interceptor) {
+		interceptor.paginator = paginator
+	}
+}
+
+// WithMethodPaginator : the paginator of the method , the per-method policies (WithLimits, WithSortPolicy, WithStrictMode ...)
+//
+// example :
+//			paginationgrpc.WithMethodPaginator("/user.v1.UserService/ListUsers", pagination.NewPaginator(
+//				pagination.WithLimits(&pagination.PagingLimits{PageSize: pagination.PagingLimit{Max: 100, Reject: true}}),
+//				pagination.WithSortPolicy(pagination.NewSortPolicy(map[string]string{"created_at": "created_at"})),
+//			))
+func WithMethodPaginator(fullMethod string, paginator *pagination.Paginator) InterceptorOption {
+	return func(interceptor *interceptor) {
+		interceptor.methods[fullMethod] = paginator
+	}
+}
+
+// UnaryServerInterceptor : normalize the paging option fields of the request (protobuf reflection) ,
+// the top-level paging option field is created when it is not set ,
+// and the invalid paging option is rejected with codes.InvalidArgument && errdetails.BadRequest field violations
+//
+// example :
+//			server := grpc.NewServer(grpc.UnaryInterceptor(paginationgrpc.UnaryServerInterceptor(
+//				paginationgrpc.WithMethodPaginator("/user.v1.UserService/ListUsers", paginator),
+//			)))
+func UnaryServerInterceptor(options ...InterceptorOption) grpc.UnaryServerInterceptor {
+
+	interceptor := &interceptor{methods: make(map[string]*pagination.Paginator)}
+	for _, option := range options {
+		option(interceptor)
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if message, ok := req.(protoadapt.MessageV1); ok {
+			if err := interceptor.normalize(info.FullMethod, protoadapt.MessageV2Of(message).ProtoReflect()); err != nil {
+				return nil, err
+			}
+		} else if message, ok := req.(protoadapt.MessageV2); ok {
+			if err := interceptor.normalize(info.FullMethod, message.ProtoReflect()); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// normalize the paging option fields of the request
+func (interceptor *interceptor) normalize(fullMethod string, message protoreflect.Message) error {
+
+	paginator := interceptor.methods[fullMethod]
+	if paginator == nil {
+		paginator = interceptor.paginator
+	}
+	if paginator == nil {
+		paginator = pagination.NewPaginator()
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	walkPagingOptions(message, "", true, func(path string, pagingOption *pagination.PagingOption) {
+		violations = append(violations, checkPagingOption(paginator, path, pagingOption)...)
+	})
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st, err := status.New(codes.InvalidArgument, "invalid paging option").WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid paging option")
+	}
+	return st.Err()
+}
+
+// walkPagingOptions the paging option fields of the message , the nested messages are walked when they are set
+func walkPagingOptions(message protoreflect.Message, prefix string, topLevel bool, fn func(path string, pagingOption *pagination.PagingOption)) {
+
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			continue
+		}
+
+		path := prefix + string(field.Name())
+
+		// paging option : create the top-level field when it is not set
+		if field.Message().FullName() == pagingOptionName {
+			if !topLevel && !message.Has(field) {
+				continue
+			}
+			value := message.Mutable(field).Message().Interface()
+			if pagingOption, ok := value.(*pagination.PagingOption); ok {
+				fn(path, pagingOption)
+			}
+			continue
+		}
+
+		// nested message
+		if message.Has(field) {
+			walkPagingOptions(message.Get(field).Message(), path+".", false, fn)
+		}
+	}
+}
+
+// checkPagingOption normalize the paging option , the errors => field violations
+func checkPagingOption(paginator *pagination.Paginator, path string, pagingOption *pagination.PagingOption) []*errdetails.BadRequest_FieldViolation {
+
+	// init (strict mode, limits) && check (sort policy, cursor token)
+	_, err := paginator.GetOptionCollection(pagingOption)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors pagination.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErrors))
+		for _, validationError := range validationErrors {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       path + "." + validationError.Field,
+				Description: validationError.Reason,
+			})
+		}
+		return violations
+	}
+
+	var cursorError *pagination.CursorError
+	if errors.As(err, &cursorError) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: path + ".cursor", Description: cursorError.Error()}}
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: err.Error()}}
+}
@@ -0,0 +1,121 @@
+package paginationgrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pagination "github.com/ikaiguang/go-pagination"
+	"github.com/ikaiguang/go-pagination/paginationgrpc/internal/testpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// the test service methods
+const (
+	listUsersMethod  = "/testpb.UserService/ListUsers"
+	listAdminsMethod = "/testpb.UserService/ListAdmins"
+)
+
+// userServer the test service , return the normalized paging option
+type userServer interface {
+	list(ctx context.Context, req *testpb.ListUsersRequest) (*testpb.ListUsersResponse, error)
+}
+
+type testUserServer struct{}
+
+func (s *testUserServer) list(ctx context.Context, req *testpb.ListUsersRequest) (*testpb.ListUsersResponse, error) {
+	return &testpb.ListUsersResponse{Paging: &pagination.PagingResult{Option: req.Paging}}, nil
+}
+
+// listHandler the unary handler of the test service
+func listHandler(fullMethod string) func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		req := new(testpb.ListUsersRequest)
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.(userServer).list(ctx, req.(*testpb.ListUsersRequest))
+		})
+	}
+}
+
+// newTestConn the bufconn client of the test service
+func newTestConn(t *testing.T, options ...InterceptorOption) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor(options...)))
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "testpb.UserService",
+		HandlerType: (*userServer)(nil),
+		Methods: []grpc.MethodDesc{
+			{MethodName: "ListUsers", Handler: listHandler(listUsersMethod)},
+			{MethodName: "ListAdmins", Handler: listHandler(listAdminsMethod)},
+		},
+	}, &testUserServer{})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("\n testing : grpc.NewClient error : %v \n", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// normalize && reject the paging option of the requests
+func TestUnaryServerInterceptor(t *testing.T) {
+	conn := newTestConn(t,
+		WithPaginator(pagination.NewPaginator(pagination.WithPageSize(20))),
+		WithMethodPaginator(listAdminsMethod, pagination.NewPaginator(
+			pagination.WithLimits(&pagination.PagingLimits{PageSize: pagination.PagingLimit{Max: 50, Reject: true}}),
+			pagination.WithSortPolicy(pagination.NewSortPolicy(map[string]string{"created_at": "created_at"})),
+		)),
+	)
+	ctx := context.Background()
+
+	// the unset paging option is created && normalized
+	res := new(testpb.ListUsersResponse)
+	if err := conn.Invoke(ctx, listUsersMethod, &testpb.ListUsersRequest{}, res); err != nil {
+		t.Errorf("\n testing : ListUsers error : %v \n", err)
+		return
+	}
+	if option := res.Paging.Option; option.GetPageSize() != 20 || option.GetGotoPageNumber() != 1 || option.PagingMode != pagination.PagingModeNumber {
+		t.Errorf("\n testing : ListUsers paging option error : %+v \n", option)
+	}
+
+	// the method policies : max page size && sortable columns
+	err := conn.Invoke(ctx, listAdminsMethod, &testpb.ListUsersRequest{
+		Paging: &pagination.PagingOption{PageSize: proto.Int64(100), OrderBy: []*pagination.PagingOrder{{Column: "password", Direction: "asc"}}},
+		Scope:  &testpb.ListUsersScope{Paging: &pagination.PagingOption{Cursor: "invalid"}},
+	}, res)
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument || len(st.Details()) != 1 {
+		t.Errorf("\n testing : ListAdmins should fail with InvalidArgument : %v \n", err)
+		return
+	}
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	if !ok || len(badRequest.FieldViolations) != 2 {
+		t.Errorf("\n testing : ListAdmins field violations error : %v \n", st.Details())
+		return
+	}
+	if field := badRequest.FieldViolations[0].Field; field != "paging.page_size" {
+		t.Errorf("\n testing : field violation error : %s \n", field)
+	}
+	if field := badRequest.FieldViolations[1].Field; field != "scope.paging.cursor" {
+		t.Errorf("\n testing : field violation error : %s \n", field)
+	}
+}
@@ -0,0 +1,155 @@
+package paginationhttp
+
+import (
+	"net/url"
+
+	pagination "github.com/ikaiguang/go-pagination"
+)
+
+// DefaultJSONAPIBinder : the binder of the JSON:API page parameters (BindJSONAPI, JSONAPI) ,
+// page[number], page[size], page[after], page[before] && sort
+var DefaultJSONAPIBinder = NewJSONAPIBinder()
+
+// NewJSONAPIBinder : the binder of the JSON:API page parameters
+func NewJSONAPIBinder() *Binder {
+	return &Binder{
+		Page:     "page[number]",
+		PageSize: "page[size]",
+		Sort:     "sort",
+		Cursor:   "page[after]",
+		Before:   "page[before]",
+	}
+}
+
+// PageMeta : the paging meta of the envelope
+type PageMeta struct {
+	Total       *int64        `json:"total,omitempty"`       // total records (nil : unknown in peek mode)
+	Approximate bool          `json:"approximate,omitempty"` // the total records is approximate
+	Page        *PageMetaPage `json:"page"`                  // page
+}
+
+// PageMetaPage : the page of the paging meta
+type PageMetaPage struct {
+	Number int64 `json:"number"`         // current page
+	Size   int64 `json:"size"`           // page size
+	Last   int64 `json:"last,omitempty"` // last page (0 : unknown)
+}
+
+// JSONAPILinks : JSON:API links
+type JSONAPILinks struct {
+	Self  string `json:"self"`            // the request
+	First string `json:"first,omitempty"` // first page
+	Prev  string `json:"prev,omitempty"`  // preceding page
+	Next  string `json:"next,omitempty"`  // next page
+	Last  string `json:"last,omitempty"`  // last page
+}
+
+// JSONAPIDocument : JSON:API top-level document
+type JSONAPIDocument struct {
+	Data  interface{}   `json:"data"`  // primary data
+	Links *JSONAPILinks `json:"links"` // links
+	Meta  *PageMeta     `json:"meta"`  // meta
+}
+
+// HALLink : HAL link object
+type HALLink struct {
+	Href string `json:"href"` // url
+}
+
+// HALDocument : HAL resource , the records are embedded by the rel
+type HALDocument struct {
+	Links     map[string]*HALLink    `json:"_links"`              // self, first, prev, next, last
+	Embedded  map[string]interface{} `json:"_embedded,omitempty"` // the records
+	*PageMeta                        // total && page
+}
+
+// BindJSONAPI : the JSON:API page parameters => validated paging option (DefaultJSONAPIBinder)
+//
+// example : ?page[number]=2&page[size]=20 ; ?page[size]=20&page[after]=... ; ?page[size]=20&page[before]=...
+func BindJSONAPI(values url.Values) (*pagination.PagingOption, error) {
+	return DefaultJSONAPIBinder.Bind(values)
+}
+
+// JSONAPI : JSON:API document of the records (DefaultJSONAPIBinder)
+func JSONAPI(data interface{}, result *pagination.PagingResult, requestURL *url.URL) (*JSONAPIDocument, error) {
+	return DefaultJSONAPIBinder.JSONAPI(data, result, requestURL)
+}
+
+// HAL : HAL resource of the records (DefaultBinder)
+func HAL(rel string, data interface{}, result *pagination.PagingResult, requestURL *url.URL) (*HALDocument, error) {
+	return DefaultBinder.HAL(rel, data, result, requestURL)
+}
+
+// JSONAPI : JSON:API document of the records , links (self, first, prev, next, last) && meta (total, page)
+//
+// example :
+//			document, err := paginationhttp.JSONAPI(users, result, r.URL)
+//			err = json.NewEncoder(w).Encode(document)
+func (binder *Binder) JSONAPI(data interface{}, result *pagination.PagingResult, requestURL *url.URL) (*JSONAPIDocument, error) {
+
+	links, err := binder.Links(result, requestURL)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &JSONAPIDocument{
+		Data: data,
+		Links: &JSONAPILinks{
+			Self:  links.Self,
+			First: links.First,
+			Prev:  links.Prev,
+			Next:  links.Next,
+			Last:  links.Last,
+		},
+		Meta: newPageMeta(result),
+	}
+	return document, nil
+}
+
+// HAL : HAL resource of the records , _links (self, first, prev, next, last) , _embedded (rel : records) && total, page
+//
+// example :
+//			document, err := paginationhttp.HAL("users", users, result, r.URL)
+func (binder *Binder) HAL(rel string, data interface{}, result *pagination.PagingResult, requestURL *url.URL) (*HALDocument, error) {
+
+	links, err := binder.Links(result, requestURL)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &HALDocument{
+		Links:    make(map[string]*HALLink),
+		Embedded: map[string]interface{}{rel: data},
+		PageMeta: newPageMeta(result),
+	}
+	for rel, href := range map[string]string{
+		"self":  links.Self,
+		"first": links.First,
+		"prev":  links.Prev,
+		"next":  links.Next,
+		"last":  links.Last,
+	} {
+		if href != "" {
+			document.Links[rel] = &HALLink{Href: href}
+		}
+	}
+	return document, nil
+}
+
+// newPageMeta the paging meta of the paging result
+func newPageMeta(result *pagination.PagingResult) *PageMeta {
+
+	meta := &PageMeta{
+		Approximate: result.TotalSizeApproximate,
+		Page: &PageMetaPage{
+			Number: result.CurrentPage,
+			Size:   result.PageSize,
+			Last:   result.LastPage,
+		},
+	}
+	if hasTotal(result) {
+		total := result.TotalSize
+		meta.Total = &total
+	}
+	return meta
+}
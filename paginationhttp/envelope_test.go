@@ -0,0 +1,129 @@
+package paginationhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	pagination "github.com/ikaiguang/go-pagination"
+)
+
+// JSON:API && HAL envelopes
+func TestEnvelope(t *testing.T) {
+	requestURL, _ := url.Parse("https://api.example.com/users?page%5Bnumber%5D=2&page%5Bsize%5D=10")
+	result := &pagination.PagingResult{
+		PagingMode:      pagination.PagingModeNumber,
+		TotalSize:       25,
+		PageSize:        10,
+		CurrentPage:     2,
+		LastPage:        3,
+		HasNextPage:     true,
+		HasPreviousPage: true,
+	}
+
+	document, err := JSONAPI([]string{"user"}, result, requestURL)
+	if err != nil {
+		t.Errorf("\n testing : JSONAPI error : %v \n", err)
+		return
+	}
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(document)
+
+	data := bytes.TrimSpace(buffer.Bytes())
+	want := `{"data":["user"],"links":{` +
+		`"self":"https://api.example.com/users?page%5Bnumber%5D=2&page%5Bsize%5D=10",` +
+		`"first":"https://api.example.com/users?page%5Bsize%5D=10",` +
+		`"prev":"https://api.example.com/users?page%5Bnumber%5D=1&page%5Bsize%5D=10",` +
+		`"next":"https://api.example.com/users?page%5Bnumber%5D=3&page%5Bsize%5D=10",` +
+		`"last":"https://api.example.com/users?page%5Bnumber%5D=3&page%5Bsize%5D=10"},` +
+		`"meta":{"total":25,"page":{"number":2,"size":10,"last":3}}}`
+	if string(data) != want {
+		t.Errorf("\n testing : JSONAPI json error : %s \n", data)
+	}
+
+	hal, err := HAL("users", []string{"user"}, result, requestURL)
+	if err != nil {
+		t.Errorf("\n testing : HAL error : %v \n", err)
+		return
+	}
+	data, _ = json.Marshal(hal)
+
+	var halJSON struct {
+		Links    map[string]*HALLink `json:"_links"`
+		Embedded map[string][]string `json:"_embedded"`
+		Total    int64               `json:"total"`
+	}
+	if err = json.Unmarshal(data, &halJSON); err != nil || len(halJSON.Links) != 5 || halJSON.Embedded["users"][0] != "user" || halJSON.Total != 25 {
+		t.Errorf("\n testing : HAL json error : %v %s \n", err, data)
+	}
+}
+
+// JSON:API page parameters => paging option
+func TestBindJSONAPI(t *testing.T) {
+	option, err := BindJSONAPI(url.Values{"page[number]": {"3"}, "page[size]": {"5"}, "sort": {"-id"}})
+	if err != nil || option.GetGotoPageNumber() != 3 || option.GetPageSize() != 5 || FormatSort(option.OrderBy) != "-id" {
+		t.Errorf("\n testing : BindJSONAPI error : %v %+v \n", err, option)
+		return
+	}
+
+	type Model struct {
+		Id int64
+	}
+
+	// page[after] : the next page
+	option, _ = BindJSONAPI(url.Values{"page[size]": {"2"}, "page[after]": {""}})
+	collection, err := pagination.GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+	result, err := pagination.SetPagingResult(collection, &pagination.PagingResultCollection{TotalRecords: 10, ResultSlice: []Model{{Id: 10}, {Id: 9}}})
+	if err != nil {
+		t.Errorf("\n testing : SetPagingResult error : %v \n", err)
+		return
+	}
+
+	option, err = BindJSONAPI(url.Values{"page[size]": {"2"}, "page[after]": {result.NextCursor}})
+	if err != nil {
+		t.Errorf("\n testing : BindJSONAPI after error : %v \n", err)
+		return
+	}
+	if collection, err = pagination.GetOptionCollection(option, &Model{}); err != nil || collection.IsReverse || collection.Where[0].Data != int64(9) {
+		t.Errorf("\n testing : page[after] error : %v %+v \n", err, collection)
+		return
+	}
+	result, err = pagination.SetPagingResult(collection, &pagination.PagingResultCollection{TotalRecords: 10, ResultSlice: []Model{{Id: 8}, {Id: 7}}})
+	if err != nil {
+		t.Errorf("\n testing : SetPagingResult error : %v \n", err)
+		return
+	}
+
+	// page[before] : the preceding page
+	queries, err := DefaultJSONAPIBinder.Queries(result, url.Values{})
+	if err != nil {
+		t.Errorf("\n testing : Queries error : %v \n", err)
+		return
+	}
+	values, _ := url.ParseQuery(queries.Prev)
+	if values.Get("page[before]") != result.StartCursor {
+		t.Errorf("\n testing : Queries prev error : %s \n", queries.Prev)
+		return
+	}
+
+	option, err = BindJSONAPI(values)
+	if err != nil {
+		t.Errorf("\n testing : BindJSONAPI before error : %v \n", err)
+		return
+	}
+	if collection, err = pagination.GetOptionCollection(option, &Model{}); err != nil || !collection.IsReverse || collection.Where[0].Data != int64(8) {
+		t.Errorf("\n testing : page[before] error : %v %+v \n", err, collection)
+	}
+
+	// page[after] && page[before]
+	if _, err = BindJSONAPI(url.Values{"page[after]": {"a"}, "page[before]": {"b"}}); err == nil {
+		t.Errorf("\n testing : BindJSONAPI should fail with page[after] && page[before] \n")
+	}
+}
@@ -0,0 +1,96 @@
+package paginationhttp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	pagination "github.com/ikaiguang/go-pagination"
+)
+
+// the paging response headers
+const (
+	HeaderLink       = "Link"          // RFC 8288 : <url>; rel="next", <url>; rel="last"
+	HeaderTotalCount = "X-Total-Count" // total records
+	HeaderPage       = "X-Page"        // current page
+	HeaderPerPage    = "X-Per-Page"    // page size
+	HeaderTotalPages = "X-Total-Pages" // last page
+)
+
+// WriteHeaders : the paging response headers of the request (DefaultBinder)
+func WriteHeaders(w http.ResponseWriter, r *http.Request, result *pagination.PagingResult) error {
+	return DefaultBinder.WriteHeaders(w, r, result)
+}
+
+// WriteHeaders : the paging response headers of the request , the link is the absolute url of the request
+//
+// example :
+//			result, err := pagination.SetPagingResult(collection, &pagination.PagingResultCollection{...})
+//			err = paginationhttp.WriteHeaders(w, r, result)
+func (binder *Binder) WriteHeaders(w http.ResponseWriter, r *http.Request, result *pagination.PagingResult) error {
+
+	if r == nil || r.URL == nil {
+		return fmt.Errorf("http.Request cannot be a nil pointer")
+	}
+
+	// absolute url
+	requestURL := *r.URL
+	if requestURL.Host == "" {
+		requestURL.Host = r.Host
+	}
+	if requestURL.Scheme == "" {
+		requestURL.Scheme = "http"
+		if r.TLS != nil {
+			requestURL.Scheme = "https"
+		}
+	}
+	return binder.SetHeaders(w.Header(), &requestURL, result)
+}
+
+// hasTotal the total records is known , unknown without the total records in peek mode
+func hasTotal(result *pagination.PagingResult) bool {
+	return result.TotalSize > 0 || (result.ShowTo == 0 && !result.HasNextPage && !result.HasPreviousPage)
+}
+
+// SetHeaders : the paging headers of the request url ,
+// Link (first, prev, next, last) , X-Total-Count , X-Page , X-Per-Page , X-Total-Pages
+//
+// the total headers (X-Total-Count, X-Total-Pages) are omitted with the unknown total records (peek mode)
+func (binder *Binder) SetHeaders(header http.Header, requestURL *url.URL, result *pagination.PagingResult) error {
+
+	pageLinks, err := binder.Links(result, requestURL)
+	if err != nil {
+		return err
+	}
+
+	// link
+	var links []string
+	for _, link := range []struct{ rel, href string }{
+		{rel: "first", href: pageLinks.First},
+		{rel: "prev", href: pageLinks.Prev},
+		{rel: "next", href: pageLinks.Next},
+		{rel: "last", href: pageLinks.Last},
+	} {
+		if link.href == "" {
+			continue
+		}
+		links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, link.href, link.rel))
+	}
+	if len(links) > 0 {
+		header.Set(HeaderLink, strings.Join(links, ", "))
+	}
+
+	// page
+	header.Set(HeaderPage, strconv.FormatInt(result.CurrentPage, 10))
+	header.Set(HeaderPerPage, strconv.FormatInt(result.PageSize, 10))
+
+	// total : unknown without the total records in peek mode
+	if !hasTotal(result) {
+		return nil
+	}
+	header.Set(HeaderTotalCount, strconv.FormatInt(result.TotalSize, 10))
+	header.Set(HeaderTotalPages, strconv.FormatInt(result.LastPage, 10))
+	return nil
+}
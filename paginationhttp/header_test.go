@@ -0,0 +1,60 @@
+package paginationhttp
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pagination "github.com/ikaiguang/go-pagination"
+)
+
+// Link && X-Total-Count headers
+func TestWriteHeaders(t *testing.T) {
+	r := httptest.NewRequest("GET", "/users?page=2&page_size=10", nil)
+	w := httptest.NewRecorder()
+
+	err := WriteHeaders(w, r, &pagination.PagingResult{
+		PagingMode:      pagination.PagingModeNumber,
+		TotalSize:       45,
+		PageSize:        10,
+		CurrentPage:     2,
+		LastPage:        5,
+		HasNextPage:     true,
+		HasPreviousPage: true,
+	})
+	if err != nil {
+		t.Errorf("\n testing : WriteHeaders error : %v \n", err)
+		return
+	}
+
+	link := `<http://example.com/users?page_size=10>; rel="first", ` +
+		`<http://example.com/users?page=1&page_size=10>; rel="prev", ` +
+		`<http://example.com/users?page=3&page_size=10>; rel="next", ` +
+		`<http://example.com/users?page=5&page_size=10>; rel="last"`
+	header := w.Header()
+	if header.Get(HeaderLink) != link {
+		t.Errorf("\n testing : Link error : %s \n", header.Get(HeaderLink))
+	}
+	if header.Get(HeaderTotalCount) != "45" || header.Get(HeaderPage) != "2" || header.Get(HeaderPerPage) != "10" || header.Get(HeaderTotalPages) != "5" {
+		t.Errorf("\n testing : X-* headers error : %v \n", header)
+	}
+
+	// cursor mode in peek mode : no prev && unknown total
+	w = httptest.NewRecorder()
+	err = WriteHeaders(w, httptest.NewRequest("GET", "https://api.example.com/users?cursor=", nil), &pagination.PagingResult{
+		PagingMode:  pagination.PagingModeCursor,
+		PageSize:    10,
+		CurrentPage: 1,
+		ShowFrom:    1,
+		ShowTo:      10,
+		HasNextPage: true,
+		NextCursor:  "abc",
+	})
+	link = w.Header().Get(HeaderLink)
+	if err != nil || !strings.Contains(link, `<https://api.example.com/users?cursor=abc&page_size=10>; rel="next"`) || strings.Contains(link, `rel="prev"`) {
+		t.Errorf("\n testing : cursor Link error : %v %s \n", err, link)
+	}
+	if w.Header().Get(HeaderTotalCount) != "" || w.Header().Get(HeaderPage) != "1" {
+		t.Errorf("\n testing : cursor X-* headers error : %v \n", w.Header())
+	}
+}
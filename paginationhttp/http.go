@@ -0,0 +1,328 @@
+// Package paginationhttp bind the http query string to pagination.PagingOption ,
+// and build the query string of the pages from pagination.PagingResult
+package paginationhttp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	pagination "github.com/ikaiguang/go-pagination"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultBinder : the binder of Bind, BindRequest and Queries
+var DefaultBinder = NewBinder()
+
+// Binder : the query parameter names && the paginator
+//
+// example : ?page=2&page_size=20&sort=-created_at,id&cursor=...
+type Binder struct {
+	Page       string // page number parameter (default : page)
+	PageSize   string // page size parameter (default : page_size)
+	Sort       string // sort parameter (default : sort) , -column is desc (example : -created_at,id)
+	Cursor     string // cursor token parameter (default : cursor) , the cursor parameter (even empty) is cursor mode
+	Before     string // the records before the row cursor parameter (default : empty, disabled) , PagingResult.StartCursor of the preceding page
+	CursorMode bool   // cursor mode without the cursor token , the sort is the cursor columns (default : false)
+
+	Paginator *pagination.Paginator // init && validate the paging option (default : nil, the package-level paginator)
+}
+
+// NewBinder : the binder of the default parameter names
+func NewBinder() *Binder {
+	return &Binder{
+		Page:     "page",
+		PageSize: "page_size",
+		Sort:     "sort",
+		Cursor:   "cursor",
+	}
+}
+
+// PageQueries : the query string of the first, preceding, next and last page (empty : no page)
+type PageQueries struct {
+	First string // first page
+	Prev  string // preceding page
+	Next  string // next page
+	Last  string // last page (empty : unknown last page of page number mode)
+}
+
+// PageLinks : the url of the request, first, preceding, next and last page (empty : no page)
+type PageLinks struct {
+	Self  string // the request
+	First string // first page
+	Prev  string // preceding page
+	Next  string // next page
+	Last  string // last page (empty : unknown last page of page number mode)
+}
+
+// Bind : url.Values => validated paging option (DefaultBinder)
+func Bind(values url.Values) (*pagination.PagingOption, error) {
+	return DefaultBinder.Bind(values)
+}
+
+// BindRequest : *http.Request query string => validated paging option (DefaultBinder)
+func BindRequest(r *http.Request) (*pagination.PagingOption, error) {
+	return DefaultBinder.BindRequest(r)
+}
+
+// Queries : the query string of the pages (DefaultBinder)
+func Queries(result *pagination.PagingResult, values url.Values) (*PageQueries, error) {
+	return DefaultBinder.Queries(result, values)
+}
+
+// BindRequest : *http.Request query string => validated paging option
+func (binder *Binder) BindRequest(r *http.Request) (*pagination.PagingOption, error) {
+
+	if r == nil || r.URL == nil {
+		return nil, fmt.Errorf("http.Request cannot be a nil pointer")
+	}
+	return binder.Bind(r.URL.Query())
+}
+
+// Bind : url.Values => validated paging option ,
+// the invalid parameters return pagination.ValidationErrors (the field is the parameter name)
+//
+// example :
+//			option, err := paginationhttp.Bind(r.URL.Query())
+//			collection, err := pagination.GetOptionCollection(option, &User{})
+func (binder *Binder) Bind(values url.Values) (*pagination.PagingOption, error) {
+
+	paginator := binder.getPaginator()
+	pagingOption := paginator.DefaultPagingOption()
+
+	var errs pagination.ValidationErrors
+	invalid := func(field string, value interface{}, reason string) {
+		errs = append(errs, &pagination.ValidationError{Field: field, Value: fmt.Sprint(value), Reason: reason})
+	}
+
+	// page number
+	if value := values.Get(binder.Page); value != "" {
+		page, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || page < 1 {
+			invalid(binder.Page, value, "must be a number greater than 0")
+		} else {
+			pagingOption.GotoPageNumber = proto.Int64(page)
+		}
+	}
+
+	// page size
+	if value := values.Get(binder.PageSize); value != "" {
+		pageSize, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || pageSize < 1 {
+			invalid(binder.PageSize, value, "must be a number greater than 0")
+		} else {
+			pagingOption.PageSize = proto.Int64(pageSize)
+		}
+	}
+
+	// sort
+	orders, err := ParseSort(values.Get(binder.Sort))
+	if err != nil {
+		invalid(binder.Sort, values.Get(binder.Sort), err.Error())
+	}
+
+	// cursor
+	cursor := values.Get(binder.Cursor)
+
+	// before the row cursor
+	before := ""
+	if binder.Before != "" {
+		before = values.Get(binder.Before)
+	}
+	if before != "" && cursor != "" {
+		invalid(binder.Before, before, "cannot be used with "+binder.Cursor)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	// paging mode
+	if values.Has(binder.Cursor) || before != "" || binder.CursorMode {
+		pagingOption.PagingMode = pagination.PagingModeCursor
+		pagingOption.Cursor = cursor
+		pagingOption.CursorColumns = orders
+	} else {
+		pagingOption.OrderBy = orders
+	}
+
+	// the backward cursor of the row cursor
+	if before != "" {
+		connectionOption, err := paginator.ConnectionOption(&pagination.ConnectionArgs{Last: pagingOption.GetPageSize(), Before: before})
+		if err != nil {
+			return nil, err
+		}
+		pagingOption.Cursor = connectionOption.Cursor
+	}
+
+	// init && validate
+	if err := paginator.InitPagingOption(pagingOption); err != nil {
+		return nil, err
+	}
+	return pagingOption, nil
+}
+
+// ParseSort : the compact sort syntax => order by , -column is desc , +column or column is asc
+//
+// example : -created_at,id => created_at desc , id asc
+func ParseSort(sort string) ([]*pagination.PagingOrder, error) {
+
+	orders := make([]*pagination.PagingOrder, 0)
+	for _, item := range strings.Split(sort, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+
+		order := &pagination.PagingOrder{Column: item, Direction: "asc"}
+		switch item[0] {
+		case '-':
+			order.Column, order.Direction = item[1:], "desc"
+		case '+':
+			order.Column = item[1:]
+		}
+
+		if order.Column = strings.TrimSpace(order.Column); order.Column == "" {
+			return nil, fmt.Errorf("sort column cannot be empty")
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// FormatSort : order by => the compact sort syntax (example : -created_at,id)
+func FormatSort(orders []*pagination.PagingOrder) string {
+
+	items := make([]string, 0, len(orders))
+	for _, order := range orders {
+		if order == nil {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(order.Direction), "desc") {
+			items = append(items, "-"+order.Column)
+		} else {
+			items = append(items, order.Column)
+		}
+	}
+	return strings.Join(items, ",")
+}
+
+// Queries : the query string of the first, preceding, next and last page ,
+// the other parameters of values are kept (example : the filters)
+//
+// page number mode : page ; cursor mode : cursor (the last page is the backward cursor without values)
+//
+// example :
+//			queries, err := paginationhttp.Queries(result, r.URL.Query())
+//			next := r.URL.Path + "?" + queries.Next
+func (binder *Binder) Queries(result *pagination.PagingResult, values url.Values) (*PageQueries, error) {
+
+	if result == nil {
+		return nil, fmt.Errorf("PagingResult cannot be a nil pointer")
+	}
+
+	// page query
+	query := func(set func(values url.Values)) string {
+		pageValues := url.Values{}
+		for key, value := range values {
+			pageValues[key] = append([]string(nil), value...)
+		}
+		pageValues.Del(binder.Page)
+		pageValues.Del(binder.Cursor)
+		if binder.Before != "" {
+			pageValues.Del(binder.Before)
+		}
+		if result.PageSize > 0 {
+			pageValues.Set(binder.PageSize, strconv.FormatInt(result.PageSize, 10))
+		}
+		set(pageValues)
+		return pageValues.Encode()
+	}
+	queries := &PageQueries{First: query(func(url.Values) {})}
+
+	// cursor mode
+	if result.PagingMode == pagination.PagingModeCursor {
+		// the first page : the empty cursor
+		queries.First = query(func(values url.Values) { values.Set(binder.Cursor, "") })
+
+		if result.NextCursor != "" {
+			queries.Next = query(func(values url.Values) { values.Set(binder.Cursor, result.NextCursor) })
+		}
+		if result.PrevCursor != "" {
+			queries.Prev = query(func(values url.Values) { values.Set(binder.Cursor, result.PrevCursor) })
+		}
+		if binder.Before != "" && result.PrevCursor != "" && result.StartCursor != "" {
+			queries.Prev = query(func(values url.Values) { values.Set(binder.Before, result.StartCursor) })
+		}
+
+		// the last page : the backward cursor without values
+		cursorColumns := result.CursorColumns
+		if len(cursorColumns) == 0 {
+			cursorColumns = []*pagination.PagingOrder{{Column: result.CursorColumn, Direction: result.CursorDirection}}
+		}
+		page := result.LastPage
+		if page < 1 {
+			page = 1
+		}
+		token, err := binder.getPaginator().EncodeCursor(&pagination.PagingCursor{Columns: cursorColumns, Page: page, Backward: true})
+		if err != nil {
+			return nil, err
+		}
+		queries.Last = query(func(values url.Values) { values.Set(binder.Cursor, token) })
+		return queries, nil
+	}
+
+	// page number mode
+	pageQuery := func(page int64) string {
+		return query(func(values url.Values) { values.Set(binder.Page, strconv.FormatInt(page, 10)) })
+	}
+	if result.HasPreviousPage {
+		queries.Prev = pageQuery(result.CurrentPage - 1)
+	}
+	if result.HasNextPage {
+		queries.Next = pageQuery(result.CurrentPage + 1)
+	}
+	if result.LastPage > 0 {
+		queries.Last = pageQuery(result.LastPage)
+	}
+	return queries, nil
+}
+
+// Links : the url of the request, first, preceding, next and last page (the page query of the request url)
+func (binder *Binder) Links(result *pagination.PagingResult, requestURL *url.URL) (*PageLinks, error) {
+
+	if requestURL == nil {
+		return nil, fmt.Errorf("request url cannot be a nil pointer")
+	}
+
+	queries, err := binder.Queries(result, requestURL.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	link := func(query string) string {
+		if query == "" {
+			return ""
+		}
+		linkURL := *requestURL
+		linkURL.RawQuery = query
+		return linkURL.String()
+	}
+	return &PageLinks{
+		Self:  requestURL.String(),
+		First: link(queries.First),
+		Prev:  link(queries.Prev),
+		Next:  link(queries.Next),
+		Last:  link(queries.Last),
+	}, nil
+}
+
+// getPaginator the paginator of the binder
+func (binder *Binder) getPaginator() *pagination.Paginator {
+
+	if binder.Paginator == nil {
+		return pagination.NewPaginator()
+	}
+	return binder.Paginator
+}
@@ -0,0 +1,87 @@
+package paginationhttp
+
+import (
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	pagination "github.com/ikaiguang/go-pagination"
+)
+
+// query string => paging option
+func TestBind(t *testing.T) {
+	r := httptest.NewRequest("GET", "/users?page=2&page_size=20&sort=-created_at,id&status=1", nil)
+
+	option, err := BindRequest(r)
+	if err != nil {
+		t.Errorf("\n testing : BindRequest error : %v \n", err)
+		return
+	}
+	if option.PagingMode != pagination.PagingModeNumber || option.GetGotoPageNumber() != 2 || option.GetPageSize() != 20 || FormatSort(option.OrderBy) != "-created_at,id" {
+		t.Errorf("\n testing : BindRequest error : %+v \n", option)
+	}
+
+	// configurable parameter names && cursor mode
+	binder := NewBinder()
+	binder.PageSize, binder.Sort, binder.Cursor = "limit", "order", "after"
+
+	option, err = binder.Bind(url.Values{"limit": {"5"}, "order": {"-id"}, "after": {""}})
+	if err != nil || option.PagingMode != pagination.PagingModeCursor || option.GetPageSize() != 5 || FormatSort(option.CursorColumns) != "-id" {
+		t.Errorf("\n testing : Bind cursor error : %v %+v \n", err, option)
+	}
+
+	// invalid parameters
+	_, err = Bind(url.Values{"page": {"x"}, "page_size": {"-1"}, "sort": {"id,-"}})
+
+	var errs pagination.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 3 || errs[0].Field != "page" || errs[1].Field != "page_size" || errs[2].Field != "sort" {
+		t.Errorf("\n testing : Bind should fail with ValidationErrors : %v \n", err)
+	}
+}
+
+// paging result => the query string of the pages
+func TestQueries(t *testing.T) {
+	values := url.Values{"page": {"2"}, "sort": {"-id"}, "status": {"1"}}
+
+	queries, err := Queries(&pagination.PagingResult{
+		PagingMode:      pagination.PagingModeNumber,
+		PageSize:        10,
+		CurrentPage:     2,
+		LastPage:        5,
+		HasNextPage:     true,
+		HasPreviousPage: true,
+	}, values)
+	if err != nil {
+		t.Errorf("\n testing : Queries error : %v \n", err)
+		return
+	}
+
+	want := &PageQueries{
+		First: "page_size=10&sort=-id&status=1",
+		Prev:  "page=1&page_size=10&sort=-id&status=1",
+		Next:  "page=3&page_size=10&sort=-id&status=1",
+		Last:  "page=5&page_size=10&sort=-id&status=1",
+	}
+	if *queries != *want {
+		t.Errorf("\n testing : Queries error : %+v \n", queries)
+	}
+
+	// cursor mode : the last page
+	queries, err = Queries(&pagination.PagingResult{PagingMode: pagination.PagingModeCursor, PageSize: 3, CursorColumn: "id", CursorDirection: "desc", NextCursor: "next"}, url.Values{})
+	if err != nil || queries.Next != "cursor=next&page_size=3" || queries.Prev != "" || queries.First != "cursor=&page_size=3" {
+		t.Errorf("\n testing : Queries cursor error : %v %+v \n", err, queries)
+		return
+	}
+
+	last, _ := url.ParseQuery(queries.Last)
+	option, err := Bind(last)
+	if err != nil {
+		t.Errorf("\n testing : Bind last error : %v \n", err)
+		return
+	}
+	collection, err := pagination.GetOptionCollection(option)
+	if err != nil || !collection.IsReverse || len(collection.Where) != 0 || collection.Limit != 3 {
+		t.Errorf("\n testing : the last page error : %v %+v \n", err, collection)
+	}
+}
@@ -0,0 +1,126 @@
+package paginationsql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	pagination "github.com/ikaiguang/go-pagination"
+)
+
+// EstimateHandler : planner estimated count of the base query (example : PostgresEstimate, MySQLEstimate)
+type EstimateHandler func(ctx context.Context, db Queryer, query *Query) (int64, error)
+
+// CountQuery : the count queries of the count strategy (pagination.CountStrategy)
+//
+// example :
+//			collection.Count(ctx, paginationsql.CountQuery(db, query))
+func CountQuery(db Queryer, query *Query) *pagination.CountQuery {
+
+	countQuery := &pagination.CountQuery{
+		KeyFunc: func() string {
+			return fmt.Sprintf("%s %v", query.SQL, query.Args)
+		},
+		Count: func(ctx context.Context) (int64, error) {
+			return Count(ctx, db, query)
+		},
+		CountMax: func(ctx context.Context, max int64) (int64, error) {
+			return CountMax(ctx, db, query, max)
+		},
+	}
+
+	// planner estimated count
+	estimate := query.Estimate
+	if estimate == nil && query.Dialect == pagination.DialectPostgres {
+		estimate = PostgresEstimate
+	}
+	if estimate != nil {
+		countQuery.Estimate = func(ctx context.Context) (int64, error) {
+			return estimate(ctx, db, query)
+		}
+	}
+	return countQuery
+}
+
+// Count : SELECT COUNT(*) FROM (base query) paging_t
+func Count(ctx context.Context, db Queryer, query *Query) (int64, error) {
+	return queryInt64(ctx, db, "SELECT COUNT(*) FROM ("+query.SQL+") "+subQueryAlias, query.Args...)
+}
+
+// CountMax : count at most max records , SELECT COUNT(*) FROM (SELECT 1 FROM (base query) paging_t LIMIT max) paging_c
+func CountMax(ctx context.Context, db Queryer, query *Query, max int64) (int64, error) {
+
+	if max <= 0 {
+		return Count(ctx, db, query)
+	}
+
+	limit := strconv.FormatInt(max, 10)
+	statement := "SELECT 1 FROM (" + query.SQL + ") " + subQueryAlias + " LIMIT " + limit
+	switch query.Dialect {
+	case pagination.DialectSQLServer:
+		statement = "SELECT TOP " + limit + " 1 AS c FROM (" + query.SQL + ") " + subQueryAlias
+	case pagination.DialectOracle:
+		statement = "SELECT 1 FROM (" + query.SQL + ") " + subQueryAlias + " FETCH FIRST " + limit + " ROWS ONLY"
+	}
+	return queryInt64(ctx, db, "SELECT COUNT(*) FROM ("+statement+") paging_c", query.Args...)
+}
+
+// PostgresEstimate : the rows estimated by the postgres planner , EXPLAIN (FORMAT JSON) base query
+func PostgresEstimate(ctx context.Context, db Queryer, query *Query) (int64, error) {
+
+	rows, err := db.QueryContext(ctx, "EXPLAIN (FORMAT JSON) "+query.SQL, query.Args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var plan []byte
+	if rows.Next() {
+		if err := rows.Scan(&plan); err != nil {
+			return 0, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var explain []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explain); err != nil {
+		return 0, fmt.Errorf("postgres explain error : %v", err)
+	}
+	if len(explain) == 0 {
+		return 0, fmt.Errorf("postgres explain error : empty plan")
+	}
+	return int64(explain[0].Plan.Rows), nil
+}
+
+// MySQLEstimate : the table rows estimated by mysql information_schema.TABLES ,
+// the estimate is the rows of the table, not the rows of the base query filter
+func MySQLEstimate(table string) EstimateHandler {
+	return func(ctx context.Context, db Queryer, query *Query) (int64, error) {
+		return queryInt64(ctx, db, "SELECT COALESCE(TABLE_ROWS, 0) FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?", table)
+	}
+}
+
+// queryInt64 the int64 of the first column of the first row
+func queryInt64(ctx context.Context, db Queryer, statement string, args ...interface{}) (int64, error) {
+
+	rows, err := db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var value int64
+	if rows.Next() {
+		if err := rows.Scan(&value); err != nil {
+			return 0, err
+		}
+	}
+	return value, rows.Err()
+}
@@ -0,0 +1,23 @@
+module github.com/ikaiguang/go-pagination/paginationsql
+
+go 1.18
+
+require (
+	github.com/glebarez/go-sqlite v1.21.2 // test : sqlite memory db
+	github.com/ikaiguang/go-pagination v0.0.0
+	google.golang.org/protobuf v1.33.0
+)
+
+require (
+	github.com/dustin/go-humanize v1.0.1 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/mattn/go-isatty v0.0.17 // indirect
+	github.com/remyoudompheng/bigfft v0.0.0-20230129092748-24d4a6f8daec // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	modernc.org/libc v1.22.5 // indirect
+	modernc.org/mathutil v1.5.0 // indirect
+	modernc.org/memory v1.5.0 // indirect
+	modernc.org/sqlite v1.23.1 // indirect
+)
+
+replace github.com/ikaiguang/go-pagination => ../
@@ -0,0 +1,202 @@
+// Package paginationsql run the paging query with database/sql (*sql.DB, *sql.Tx, *sqlx.DB ...)
+package paginationsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	pagination "github.com/ikaiguang/go-pagination"
+)
+
+// Queryer : *sql.DB, *sql.Tx, *sql.Conn, *sqlx.DB, *sqlx.Tx
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Query : the base query of the paging
+//
+// the base query is wrapped as a sub query : SELECT * FROM (base query) paging_t WHERE ... ORDER BY ... LIMIT ...
+// so the base query should not contain ORDER BY && LIMIT ,
+// and the cursor && order columns must be the column names of the base query result
+type Query struct {
+	Dialect  string          // sql dialect (pagination.DialectMySQL ...)
+	SQL      string          // base query (example : SELECT id, name FROM tb_user WHERE status = ?)
+	Args     []interface{}   // base query args
+	Estimate EstimateHandler // planner estimated count of pagination.NewEstimatedCount (default : PostgresEstimate of postgres)
+}
+
+// subQueryAlias the alias of the wrapped base query
+const subQueryAlias = "paging_t"
+
+// Find : count the records (pagination.CountStrategy, not in peek mode), query the page, scan the rows and set the paging result
+//
+// @Param dest must be a slice pointer (example : &[]User{} or &[]*User{}) ,
+// the column is mapped to the field by pagination.ModelFieldIndex (struct tag, field name, embedded struct)
+//
+// example :
+//			var users []*User
+//			result, err := paginationsql.Find(ctx, db, &paginationsql.Query{
+//				Dialect: pagination.DialectPostgres,
+//				SQL:     "SELECT id, name FROM tb_user WHERE status = $1",
+//				Args:    []interface{}{1},
+//			}, option, &users)
+func Find(ctx context.Context, db Queryer, query *Query, pagingOption *pagination.PagingOption, dest interface{}) (*pagination.PagingResult, error) {
+	return FindWith(ctx, db, pagination.NewPaginator(), query, pagingOption, dest)
+}
+
+// FindWith : Find with the settings of the paginator (page size, limits, sort policy, cursor sealer, count strategy ...)
+//
+// example :
+//			paginator := pagination.NewPaginator(pagination.WithPageSize(20))
+//			var users []*User
+//			result, err := paginationsql.FindWith(ctx, db, paginator, query, option, &users)
+func FindWith(ctx context.Context, db Queryer, paginator *pagination.Paginator, query *Query, pagingOption *pagination.PagingOption, dest interface{}) (*pagination.PagingResult, error) {
+
+	if paginator == nil {
+		return nil, fmt.Errorf("Paginator cannot be a nil pointer")
+	}
+	if query == nil {
+		return nil, fmt.Errorf("Query cannot be a nil pointer")
+	}
+
+	sliceValue, elemType, err := sliceOf(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	// paging option collection
+	collection, err := paginator.GetOptionCollection(pagingOption, reflect.New(elemType).Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	// count : the count strategy (pagination.DefaultCountStrategy) , skip in peek mode (pagination.DefaultPeekMode)
+	count, err := collection.Count(ctx, CountQuery(db, query))
+	if err != nil {
+		return nil, err
+	}
+
+	// query
+	if count.Total > 0 || collection.Peek {
+		statement, args, err := Render(query, collection)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := db.QueryContext(ctx, statement, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		if err := scanRows(rows, sliceValue, elemType); err != nil {
+			return nil, err
+		}
+	}
+
+	return paginator.SetPagingResult(collection, &pagination.PagingResultCollection{
+		TotalRecords:     count.Total,
+		TotalApproximate: count.Approximate,
+		ResultSlice:      dest,
+	})
+}
+
+// Render : SELECT * FROM (base query) paging_t WHERE ... ORDER BY ... LIMIT ... && args
+func Render(query *Query, collection *pagination.PagingOptionCollection) (string, []interface{}, error) {
+
+	renderer := pagination.NewSQLRenderer(query.Dialect)
+	renderer.PlaceholderStart = len(query.Args) + 1
+
+	clause, err := renderer.Render(collection)
+	if err != nil {
+		return "", nil, err
+	}
+
+	statement := "SELECT * FROM (" + query.SQL + ") " + subQueryAlias
+	if str := clause.String(); str != "" {
+		statement += " " + str
+	}
+
+	args := make([]interface{}, 0, len(query.Args)+len(clause.Args))
+	args = append(args, query.Args...)
+	args = append(args, clause.Args...)
+	return statement, args, nil
+}
+
+// sliceOf slice value && struct type of the slice pointer
+func sliceOf(dest interface{}) (reflect.Value, reflect.Type, error) {
+
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() || destValue.Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, nil, fmt.Errorf("dest must be a slice pointer")
+	}
+
+	elemType := destValue.Elem().Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return reflect.Value{}, nil, fmt.Errorf("dest slice element isnot struct")
+	}
+	return destValue.Elem(), elemType, nil
+}
+
+// scanRows scan the rows to the slice
+func scanRows(rows *sql.Rows, sliceValue reflect.Value, elemType reflect.Type) error {
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	// unmapped column => nil index
+	fieldIndexes := make([][]int, len(columns))
+	for i, column := range columns {
+		fieldIndexes[i], _ = pagination.ModelFieldIndex(elemType, column)
+	}
+
+	isPtr := sliceValue.Type().Elem().Kind() == reflect.Ptr
+	sliceValue.Set(sliceValue.Slice(0, 0))
+
+	for rows.Next() {
+		elemValue := reflect.New(elemType)
+
+		// unmapped column => discard
+		dests := make([]interface{}, len(columns))
+		for i, index := range fieldIndexes {
+			if index == nil {
+				dests[i] = new(interface{})
+				continue
+			}
+			dests[i] = fieldAddr(elemValue.Elem(), index)
+		}
+
+		if err := rows.Scan(dests...); err != nil {
+			return err
+		}
+
+		if isPtr {
+			sliceValue.Set(reflect.Append(sliceValue, elemValue))
+		} else {
+			sliceValue.Set(reflect.Append(sliceValue, elemValue.Elem()))
+		}
+	}
+	return rows.Err()
+}
+
+// fieldAddr the field pointer of the index path , allocate the nil embedded struct pointer
+func fieldAddr(structValue reflect.Value, index []int) interface{} {
+
+	for i, x := range index {
+		if i > 0 && structValue.Kind() == reflect.Ptr {
+			if structValue.IsNil() {
+				structValue.Set(reflect.New(structValue.Type().Elem()))
+			}
+			structValue = structValue.Elem()
+		}
+		structValue = structValue.Field(x)
+	}
+	return structValue.Addr().Interface()
+}
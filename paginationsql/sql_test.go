@@ -0,0 +1,250 @@
+package paginationsql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	_ "github.com/glebarez/go-sqlite"
+	pagination "github.com/ikaiguang/go-pagination"
+	"google.golang.org/protobuf/proto"
+)
+
+type User struct {
+	Id       int64
+	UserName string
+	Level    int64 `db:"user_level"`
+}
+
+// sqlite memory db with 10 users (id 1 ~ 10)
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite", "file::memory:")
+	if err != nil {
+		t.Fatalf("\n testing : sql.Open error : %v \n", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec("CREATE TABLE tb_user (id INTEGER PRIMARY KEY, user_name TEXT, user_level INTEGER, status INTEGER)"); err != nil {
+		t.Fatalf("\n testing : CREATE TABLE error : %v \n", err)
+	}
+	for i := int64(1); i <= 10; i++ {
+		if _, err = db.Exec("INSERT INTO tb_user VALUES (?, ?, ?, ?)", i, fmt.Sprintf("user%d", i), i%3, i%2); err != nil {
+			t.Fatalf("\n testing : INSERT error : %v \n", err)
+		}
+	}
+	return db
+}
+
+// render the wrapped query
+func TestRender(t *testing.T) {
+	option := pagination.DefaultPagingOption()
+	option.PagingMode = pagination.PagingModeCursor
+	option.CurrentPageNumber = 1
+	option.GotoPageNumber = proto.Int64(2)
+	option.CursorValue = 7
+
+	collection, err := pagination.GetOptionCollection(option, &User{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	statement, args, err := Render(&Query{Dialect: pagination.DialectPostgres, SQL: "SELECT * FROM tb_user WHERE status = $1", Args: []interface{}{1}}, collection)
+	if err != nil {
+		t.Errorf("\n testing : Render error : %v \n", err)
+		return
+	}
+
+	want := `SELECT * FROM (SELECT * FROM tb_user WHERE status = $1) paging_t WHERE "id" < $2 ORDER BY "id" DESC LIMIT 15 OFFSET 0`
+	if statement != want || len(args) != 2 {
+		t.Errorf("\n testing : Render error : %s %v \n", statement, args)
+	}
+}
+
+// count, query, scan && paging result
+func TestFind(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	query := &Query{
+		Dialect: pagination.DialectSQLite,
+		SQL:     "SELECT id, user_name, user_level FROM tb_user WHERE status = ?",
+		Args:    []interface{}{0},
+	}
+
+	// number paging
+	option := pagination.DefaultPagingOption()
+	option.PageSize = proto.Int64(2)
+	option.GotoPageNumber = proto.Int64(2)
+	option.OrderBy = []*pagination.PagingOrder{{Column: "id", Direction: "asc"}}
+
+	var users []*User
+	result, err := Find(ctx, db, query, option, &users)
+	if err != nil {
+		t.Errorf("\n testing : Find error : %v \n", err)
+		return
+	}
+
+	// status = 0 : id 2, 4, 6, 8, 10
+	if result.TotalSize != 5 || result.LastPage != 3 || len(users) != 2 || users[0].Id != 6 || users[0].UserName != "user6" || users[0].Level != 0 || users[1].Level != 2 {
+		t.Errorf("\n testing : Find error : %+v %+v \n", result, users)
+	}
+
+	// cursor token paging
+	option = pagination.DefaultPagingOption()
+	option.PagingMode = pagination.PagingModeCursor
+	option.PageSize = proto.Int64(2)
+
+	var ids []int64
+	for {
+		var rows []User
+		result, err = Find(ctx, db, query, option, &rows)
+		if err != nil {
+			t.Errorf("\n testing : Find cursor error : %v \n", err)
+			return
+		}
+		for _, row := range rows {
+			ids = append(ids, row.Id)
+		}
+		if result.NextCursor == "" || len(ids) > 5 {
+			break
+		}
+
+		option = pagination.DefaultPagingOption()
+		option.PageSize = proto.Int64(2)
+		option.Cursor = result.NextCursor
+	}
+
+	if fmt.Sprint(ids) != "[10 8 6 4 2]" {
+		t.Errorf("\n testing : Find cursor error : %v \n", ids)
+	}
+}
+
+// find with the settings of the paginator
+func TestFindWith(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	query := &Query{
+		Dialect: pagination.DialectSQLite,
+		SQL:     "SELECT id, user_name, user_level FROM tb_user WHERE status = ?",
+		Args:    []interface{}{0},
+	}
+
+	if _, err := FindWith(ctx, db, nil, query, pagination.DefaultPagingOption(), &[]User{}); err == nil {
+		t.Errorf("\n testing : FindWith nil paginator error : %v \n", err)
+	}
+
+	// peek mode : no count , page size 3 of the paginator
+	paginator := pagination.NewPaginator(pagination.WithPageSize(3), pagination.WithPeekMode(true))
+	option := paginator.DefaultPagingOption()
+	option.OrderBy = []*pagination.PagingOrder{{Column: "id", Direction: "asc"}}
+
+	var users []*User
+	result, err := FindWith(ctx, db, paginator, query, option, &users)
+	if err != nil {
+		t.Errorf("\n testing : FindWith error : %v \n", err)
+		return
+	}
+
+	// status = 0 : id 2, 4, 6, 8, 10
+	if result.TotalSize != 0 || !result.HasNextPage || len(users) != 3 || users[2].Id != 6 {
+		t.Errorf("\n testing : FindWith error : %+v %+v \n", result, users)
+	}
+}
+
+// count at most max records
+func TestCountMax(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	query := &Query{Dialect: pagination.DialectSQLite, SQL: "SELECT id FROM tb_user WHERE status = ?", Args: []interface{}{0}}
+
+	count, err := pagination.NewCappedCount(3).Count(ctx, CountQuery(db, query))
+	if err != nil || count.Total != 3 || !count.Approximate {
+		t.Errorf("\n testing : capped count error : %v %+v \n", err, count)
+	}
+
+	if total, err := CountMax(ctx, db, query, 10); err != nil || total != 5 {
+		t.Errorf("\n testing : CountMax error : %v %d \n", err, total)
+	}
+}
+
+// uuid (16 bytes) of the CHAR(36) column
+type testUUID [16]byte
+
+// Scan : the canonical uuid string
+func (uuid *testUUID) Scan(value interface{}) error {
+	text, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("uuid(%v) must be a string", value)
+	}
+	data, err := hex.DecodeString(strings.Replace(text, "-", "", -1))
+	if err != nil || len(data) != 16 {
+		return fmt.Errorf("uuid(%s) invalid", text)
+	}
+	copy(uuid[:], data)
+	return nil
+}
+
+// cursor paging of the uuid key
+func TestFindUUID(t *testing.T) {
+	db, err := sql.Open("sqlite", "file::memory:")
+	if err != nil {
+		t.Fatalf("\n testing : sql.Open error : %v \n", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec("CREATE TABLE tb_device (id CHAR(36) PRIMARY KEY, name TEXT)"); err != nil {
+		t.Fatalf("\n testing : CREATE TABLE error : %v \n", err)
+	}
+	for i := 1; i <= 5; i++ {
+		if _, err = db.Exec("INSERT INTO tb_device VALUES (?, ?)", fmt.Sprintf("0000000%d-0000-0000-0000-000000000000", i), fmt.Sprintf("device%d", i)); err != nil {
+			t.Fatalf("\n testing : INSERT error : %v \n", err)
+		}
+	}
+
+	type Device struct {
+		Id   testUUID
+		Name string
+	}
+
+	ctx := context.Background()
+	query := &Query{Dialect: pagination.DialectSQLite, SQL: "SELECT id, name FROM tb_device"}
+
+	option := pagination.DefaultPagingOption()
+	option.PagingMode = pagination.PagingModeCursor
+	option.PageSize = proto.Int64(2)
+	option.CursorDirection = "asc"
+
+	var names []string
+	for {
+		var rows []Device
+		result, err := Find(ctx, db, query, option, &rows)
+		if err != nil {
+			t.Errorf("\n testing : Find uuid cursor error : %v \n", err)
+			return
+		}
+		for _, row := range rows {
+			names = append(names, row.Name)
+		}
+		if result.NextCursor == "" || len(names) > 5 {
+			break
+		}
+
+		option = pagination.DefaultPagingOption()
+		option.PageSize = proto.Int64(2)
+		option.Cursor = result.NextCursor
+	}
+
+	if fmt.Sprint(names) != "[device1 device2 device3 device4 device5]" {
+		t.Errorf("\n testing : Find uuid cursor error : %v \n", names)
+	}
+}
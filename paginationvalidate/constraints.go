@@ -0,0 +1,69 @@
+package paginationvalidate
+
+import (
+	"fmt"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// columnPattern column or table.column
+const columnPattern = `"^[A-Za-z_][A-Za-z0-9_]*(\\.[A-Za-z_][A-Za-z0-9_]*)?$"`
+
+// directionPattern deprecated direction string , case-insensitive
+const directionPattern = `"^(?i)(asc|desc|sort_direction_asc|sort_direction_desc)$"`
+
+// fieldConstraints the buf.validate constraints of the pagination.proto fields (text format of buf.validate.FieldConstraints) ,
+// pagination.proto does not import buf/validate/validate.proto , only this package depends on protovalidate
+var fieldConstraints = map[protoreflect.FullName]string{
+	// paging_option
+	"pagination.paging_option.paging_mode":           `enum: {defined_only: true}`,
+	"pagination.paging_option.current_page_number":   `int64: {gte: 0}`,
+	"pagination.paging_option.goto_page_number":      `int64: {gte: 1}`,
+	"pagination.paging_option.page_size":             `int64: {gte: 1, lte: 1000}`,
+	"pagination.paging_option.order_by":              `repeated: {max_items: 16}`,
+	"pagination.paging_option.cursor_column":         `ignore: IGNORE_IF_UNPOPULATED, string: {pattern: ` + columnPattern + `}`,
+	"pagination.paging_option.cursor_direction":      `ignore: IGNORE_IF_UNPOPULATED, string: {pattern: ` + directionPattern + `}`,
+	"pagination.paging_option.cursor_columns":        `repeated: {max_items: 16}`,
+	"pagination.paging_option.cursor_values":         `repeated: {max_items: 16}`,
+	"pagination.paging_option.cursor_sort_direction": `enum: {defined_only: true}`,
+
+	// paging_order
+	"pagination.paging_order.column":         `ignore: IGNORE_IF_UNPOPULATED, string: {pattern: ` + columnPattern + `}`,
+	"pagination.paging_order.direction":      `ignore: IGNORE_IF_UNPOPULATED, string: {pattern: ` + directionPattern + `}`,
+	"pagination.paging_order.sort_direction": `enum: {defined_only: true}`,
+}
+
+// constraintResolver the constraints of the pagination.proto fields , the other fields use the standard resolver
+type constraintResolver struct {
+	protovalidate.StandardConstraintResolver
+	fields map[protoreflect.FullName]*validate.FieldConstraints
+}
+
+// newConstraintInterceptor the interceptor of the standard resolver with the constraints of the pagination.proto fields
+func newConstraintInterceptor() (protovalidate.StandardConstraintInterceptor, error) {
+
+	fields := make(map[protoreflect.FullName]*validate.FieldConstraints, len(fieldConstraints))
+	for name, text := range fieldConstraints {
+		constraints := &validate.FieldConstraints{}
+		if err := prototext.Unmarshal([]byte(text), constraints); err != nil {
+			return nil, fmt.Errorf("field(%s) constraints invalid : %v", name, err)
+		}
+		fields[name] = constraints
+	}
+
+	return func(resolver protovalidate.StandardConstraintResolver) protovalidate.StandardConstraintResolver {
+		return &constraintResolver{StandardConstraintResolver: resolver, fields: fields}
+	}, nil
+}
+
+// ResolveFieldConstraints : the constraints of the pagination.proto field , or the standard constraints
+func (resolver *constraintResolver) ResolveFieldConstraints(desc protoreflect.FieldDescriptor) *validate.FieldConstraints {
+
+	if constraints, ok := resolver.fields[desc.FullName()]; ok {
+		return constraints
+	}
+	return resolver.StandardConstraintResolver.ResolveFieldConstraints(desc)
+}
@@ -0,0 +1,22 @@
+module github.com/ikaiguang/go-pagination/paginationvalidate
+
+go 1.20
+
+require (
+	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.33.0-20240401165935-b983156c5e99.1
+	github.com/bufbuild/protovalidate-go v0.6.2
+	github.com/ikaiguang/go-pagination v0.0.0
+	google.golang.org/protobuf v1.33.0
+)
+
+require (
+	github.com/antlr4-go/antlr/v4 v4.13.0 // indirect
+	github.com/google/cel-go v0.20.1 // indirect
+	github.com/stoewer/go-strcase v1.3.0 // indirect
+	golang.org/x/exp v0.0.0-20240325151524-a685a6edb6d8 // indirect
+	golang.org/x/text v0.20.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20240401170217-c3f982113cda // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240401170217-c3f982113cda // indirect
+)
+
+replace github.com/ikaiguang/go-pagination => ../
@@ -0,0 +1,137 @@
+// Package paginationvalidate evaluate the buf.validate (protovalidate) constraints of the pagination.proto fields (constraints.go) ,
+// and map the violations to pagination.ValidationErrors
+package paginationvalidate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bufbuild/protovalidate-go"
+	pagination "github.com/ikaiguang/go-pagination"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	defaultValidator     *Validator
+	defaultValidatorErr  error
+	defaultValidatorOnce sync.Once
+)
+
+// Validator : the protovalidate validator of the paging messages
+type Validator struct {
+	validator *protovalidate.Validator
+}
+
+// NewValidator : the validator of the paging messages (paging_option, paging_order ...)
+func NewValidator() (*Validator, error) {
+
+	interceptor, err := newConstraintInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := protovalidate.New(
+		protovalidate.WithStandardConstraintInterceptor(interceptor),
+		protovalidate.WithMessages(
+			&pagination.PagingOption{},
+			&pagination.PagingResult{},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("protovalidate init fail : %v", err)
+	}
+	return &Validator{validator: validator}, nil
+}
+
+// Validate : evaluate the constraints of the message (the package-level validator)
+//
+// example :
+//			if err := paginationvalidate.Validate(req.Paging); err != nil {
+//				return nil, err // pagination.ValidationErrors
+//			}
+//			collection, err := pagination.GetOptionCollection(req.Paging, &User{})
+func Validate(message proto.Message) error {
+
+	defaultValidatorOnce.Do(func() {
+		defaultValidator, defaultValidatorErr = NewValidator()
+	})
+	if defaultValidatorErr != nil {
+		return defaultValidatorErr
+	}
+	return defaultValidator.Validate(message)
+}
+
+// Validate : evaluate the constraints of the message ,
+// the violations return pagination.ValidationErrors (the field is the field path , example : order_by[0].column)
+func (validator *Validator) Validate(message proto.Message) error {
+
+	if message == nil {
+		return fmt.Errorf("proto.Message cannot be a nil pointer")
+	}
+
+	err := validator.validator.Validate(message)
+	if err == nil {
+		return nil
+	}
+
+	var validationError *protovalidate.ValidationError
+	if !errors.As(err, &validationError) {
+		return err
+	}
+
+	errs := make(pagination.ValidationErrors, 0, len(validationError.Violations))
+	for _, violation := range validationError.Violations {
+		errs = append(errs, &pagination.ValidationError{
+			Field:  violation.GetFieldPath(),
+			Value:  fieldValue(message.ProtoReflect(), violation.GetFieldPath()),
+			Reason: violation.GetMessage(),
+		})
+	}
+	return errs
+}
+
+// fieldValue the value of the field path (example : order_by[0].column)
+func fieldValue(message protoreflect.Message, path string) string {
+
+	var value protoreflect.Value
+	for _, segment := range strings.Split(path, ".") {
+		if message == nil {
+			return ""
+		}
+
+		// repeated field : name[index]
+		name, index := segment, -1
+		if i := strings.IndexByte(segment, '['); i > 0 && strings.HasSuffix(segment, "]") {
+			number, err := strconv.Atoi(segment[i+1 : len(segment)-1])
+			if err != nil {
+				return ""
+			}
+			name, index = segment[:i], number
+		}
+
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return ""
+		}
+		value = message.Get(field)
+		if index >= 0 {
+			if !field.IsList() || index >= value.List().Len() {
+				return ""
+			}
+			value = value.List().Get(index)
+		}
+
+		message = nil
+		if field.Kind() == protoreflect.MessageKind && (index >= 0 || !field.IsList()) {
+			message = value.Message()
+		}
+	}
+	if message != nil {
+		return ""
+	}
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,78 @@
+package paginationvalidate
+
+import (
+	"errors"
+	"testing"
+
+	pagination "github.com/ikaiguang/go-pagination"
+	"google.golang.org/protobuf/proto"
+)
+
+// buf.validate constraints => pagination.ValidationErrors
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	// valid : the unset page size && page number are the default values
+	for _, option := range []*pagination.PagingOption{
+		{},
+		{PageSize: proto.Int64(20), GotoPageNumber: proto.Int64(2), OrderBy: []*pagination.PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "u.id"}}},
+		{PagingMode: pagination.PagingModeCursor, CursorColumn: "id", CursorDirection: "ASC"},
+		{OrderBy: []*pagination.PagingOrder{{Column: "id", SortDirection: pagination.SortDirection_SORT_DIRECTION_DESC}}},
+	} {
+		if err := Validate(option); err != nil {
+			t.Errorf("\n testing : Validate error : %v %+v \n", err, option)
+		}
+	}
+
+	// invalid : the explicit 0 , the direction , the column pattern ...
+	for _, testCase := range []struct {
+		option *pagination.PagingOption
+		field  string
+		value  string
+	}{
+		{option: &pagination.PagingOption{PageSize: proto.Int64(0)}, field: "page_size", value: "0"},
+		{option: &pagination.PagingOption{PageSize: proto.Int64(1001)}, field: "page_size", value: "1001"},
+		{option: &pagination.PagingOption{GotoPageNumber: proto.Int64(0)}, field: "goto_page_number", value: "0"},
+		{option: &pagination.PagingOption{PagingMode: 3}, field: "paging_mode", value: "3"},
+		{option: &pagination.PagingOption{CursorDirection: "up"}, field: "cursor_direction", value: "up"},
+		{option: &pagination.PagingOption{CursorSortDirection: 3}, field: "cursor_sort_direction", value: "3"},
+		{option: &pagination.PagingOption{CursorColumn: "id;drop table"}, field: "cursor_column", value: "id;drop table"},
+		{option: &pagination.PagingOption{OrderBy: []*pagination.PagingOrder{{Column: "id"}, {Column: "name", Direction: "up"}}}, field: "order_by[1].direction", value: "up"},
+	} {
+		err := Validate(testCase.option)
+		var validationErrors pagination.ValidationErrors
+		if !errors.As(err, &validationErrors) || len(validationErrors) != 1 ||
+			validationErrors[0].Field != testCase.field || validationErrors[0].Value != testCase.value {
+			t.Errorf("\n testing : Validate %s error : %v \n", testCase.field, err)
+		}
+	}
+
+	// nested paging option
+	err := Validate(&pagination.PagingResult{Option: &pagination.PagingOption{PageSize: proto.Int64(0)}})
+	var validationErrors pagination.ValidationErrors
+	if !errors.As(err, &validationErrors) || validationErrors[0].Field != "option.page_size" || validationErrors[0].Value != "0" {
+		t.Errorf("\n testing : Validate nested error : %v \n", err)
+	}
+}
+
+// the constraints of the pagination.proto fields , pagination.proto does not import buf/validate
+func TestFieldConstraints(t *testing.T) {
+	t.Parallel()
+
+	file := pagination.File_pagination_proto
+	if file.Imports().Len() != 0 {
+		t.Errorf("\n testing : pagination.proto imports error : %v \n", file.Imports().Get(0).Path())
+	}
+
+	// every constraint is the constraint of a field
+	for name := range fieldConstraints {
+		message := file.Messages().ByName(name.Parent().Name())
+		if message == nil || message.Fields().ByName(name.Name()) == nil {
+			t.Errorf("\n testing : field(%s) not exist in pagination.proto \n", name)
+		}
+	}
+
+	if _, err := newConstraintInterceptor(); err != nil {
+		t.Errorf("\n testing : newConstraintInterceptor error : %v \n", err)
+	}
+}
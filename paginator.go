@@ -0,0 +1,310 @@
+package pagination
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// PageNumberOrderHandler : page number mode order (DefaultPageNumberOrderHandler)
+type PageNumberOrderHandler func(orderOptions []*PagingOrder) []*PagingOrder
+
+// CursorColumnCheckHandler : check the cursor columns of the model (DefaultCursorColumnCheckHandler)
+type CursorColumnCheckHandler func(pagingOption *PagingOption, models ...interface{}) error
+
+// CursorColumnHandler : the model has the cursor columns (DefaultCursorColumnHandler) ,
+// false : the cursor columns not exist in the model , error : the model cannot be checked (or the detail of the missing column)
+type CursorColumnHandler func(pagingOption *PagingOption, model interface{}) (bool, error)
+
+// CursorOptionCollectionHandler : cursor mode option collection (DefaultCursorOptionCollectionHandler)
+type CursorOptionCollectionHandler func(pagingOption *PagingOption) *PagingOptionCollection
+
+// CalcResultSliceHandler : calc ResultSlice (DefaultCalcResultSliceHandler)
+type CalcResultSliceHandler func(optionCollection *PagingOptionCollection, resultCollection *PagingResultCollection) (*PagingResultInfo, error)
+
+// CursorValuesHandler : typed cursor values of the record (DefaultCursorValuesHandler)
+type CursorValuesHandler func(optionCollection *PagingOptionCollection, modelStruct interface{}) ([]*PagingCursorValue, error)
+
+// CursorEncodeHandler : encode cursor token (DefaultCursorEncodeHandler)
+type CursorEncodeHandler func(cursor *PagingCursor) (string, error)
+
+// CursorDecodeHandler : decode cursor token (DefaultCursorDecodeHandler)
+type CursorDecodeHandler func(token string) (*PagingCursor, error)
+
+// Paginator : the paging defaults && strategies of an instance ,
+// the instance is not changed after NewPaginator, so it can be used by goroutines
+//
+// NewPaginator start with the package-level settings (Default*Handler, DefaultSortPolicy, DefaultPagingLimits ...) ,
+// and the package-level functions (GetOptionCollection, SetPagingResult ...) use a Paginator of the package-level settings
+//
+// example :
+//			paginator := pagination.NewPaginator(
+//				pagination.WithPageSize(20),
+//				pagination.WithOrder("created_at", "desc"),
+//				pagination.WithLimits(&pagination.PagingLimits{PageSize: pagination.PagingLimit{Max: 100}}),
+//			)
+//			collection, err := paginator.GetOptionCollection(option, &User{})
+//			result, err := paginator.SetPagingResult(collection, &pagination.PagingResultCollection{...})
+type Paginator struct {
+	pageSize         int64         // default page size
+	orderColumn      string        // default order column && cursor column
+	orderDirection   string        // default order direction && cursor direction
+	strictMode       bool          // strict validation
+	peekMode         bool          // limit+1 has next page detection
+	rowCursors       bool          // the cursor token of every record
+	limits           *PagingLimits // paging limits
+	sortPolicy       *SortPolicy   // sortable column allowlist
+	cursorExpiration time.Duration // cursor token expiration
+	cursorSealer     CursorSealer  // cursor token sealer (the page token requires it)
+	countStrategy    CountStrategy // total records strategy
+
+	pageNumberOrderHandler        PageNumberOrderHandler
+	cursorColumnCheckHandler      CursorColumnCheckHandler
+	cursorColumnHandler           CursorColumnHandler
+	cursorOptionCollectionHandler CursorOptionCollectionHandler
+	calcResultSliceHandler        CalcResultSliceHandler
+	cursorValuesHandler           CursorValuesHandler
+	cursorEncodeHandler           CursorEncodeHandler
+	cursorDecodeHandler           CursorDecodeHandler
+}
+
+// PaginatorOption : functional option of NewPaginator
+type PaginatorOption func(paginator *Paginator)
+
+// NewPaginator : paginator with the package-level settings && the options
+func NewPaginator(options ...PaginatorOption) *Paginator {
+	paginator := &Paginator{
+		pageSize:         defaultPageSize,
+		orderColumn:      defaultOrderColumn,
+		orderDirection:   defaultOrderDirection,
+		strictMode:       DefaultStrictMode,
+		peekMode:         DefaultPeekMode,
+		rowCursors:       DefaultRowCursors,
+		limits:           DefaultPagingLimits,
+		sortPolicy:       DefaultSortPolicy,
+		cursorExpiration: DefaultCursorExpiration,
+		cursorSealer:     DefaultCursorSealer,
+		countStrategy:    DefaultCountStrategy,
+
+		pageNumberOrderHandler:        DefaultPageNumberOrderHandler,
+		cursorColumnHandler:           DefaultCursorColumnHandler,
+		cursorOptionCollectionHandler: DefaultCursorOptionCollectionHandler,
+		calcResultSliceHandler:        DefaultCalcResultSliceHandler,
+		cursorValuesHandler:           DefaultCursorValuesHandler,
+		cursorEncodeHandler:           DefaultCursorEncodeHandler,
+		cursorDecodeHandler:           DefaultCursorDecodeHandler,
+	}
+
+	paginator.cursorColumnCheckHandler = paginator.checkCursorColumns
+
+	for _, option := range options {
+		option(paginator)
+	}
+	return paginator
+}
+
+// defaultPaginator paginator of the package-level settings
+func defaultPaginator() *Paginator {
+	return NewPaginator(WithCursorColumnCheckHandler(DefaultCursorColumnCheckHandler))
+}
+
+// WithPageSize : default page size (default : 15)
+func WithPageSize(pageSize int64) PaginatorOption {
+	return func(paginator *Paginator) {
+		if pageSize > 0 {
+			paginator.pageSize = pageSize
+		}
+	}
+}
+
+// WithOrder : default order column && direction of the empty order and cursor (default : id desc) ,
+// the direction is case-insensitive (asc, DESC, SORT_DIRECTION_ASC ...)
+func WithOrder(column, direction string) PaginatorOption {
+	return func(paginator *Paginator) {
+		if column = strings.TrimSpace(column); column != "" {
+			paginator.orderColumn = column
+		}
+		paginator.orderDirection = defaultOrderDirection
+		if sortDirection, err := ParseSortDirection(direction); err == nil && sortDirection != SortDirection_SORT_DIRECTION_UNSPECIFIED {
+			paginator.orderDirection = sortDirection.Direction()
+		}
+	}
+}
+
+// WithStrictMode : strict validation (see DefaultStrictMode)
+func WithStrictMode(strictMode bool) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.strictMode = strictMode
+	}
+}
+
+// WithPeekMode : limit+1 has next page detection , the total records is optional (see DefaultPeekMode)
+func WithPeekMode(peekMode bool) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.peekMode = peekMode
+	}
+}
+
+// WithRowCursors : SetPagingResult return the cursor token of every record (PagingResult.RowCursors) ,
+// only in cursor mode (see DefaultRowCursors)
+func WithRowCursors(rowCursors bool) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.rowCursors = rowCursors
+	}
+}
+
+// WithLimits : paging limits , nil is no limit (see DefaultPagingLimits)
+func WithLimits(limits *PagingLimits) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.limits = limits
+	}
+}
+
+// WithSortPolicy : sortable column allowlist , nil is no allowlist (see DefaultSortPolicy)
+func WithSortPolicy(policy *SortPolicy) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.sortPolicy = policy
+	}
+}
+
+// WithCursorSealer : seal the cursor token , nil is the plain token (see DefaultCursorSealer)
+func WithCursorSealer(sealer CursorSealer) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.cursorSealer = sealer
+		paginator.cursorEncodeHandler = func(cursor *PagingCursor) (string, error) {
+			return encodeCursorToken(cursor, sealer)
+		}
+		paginator.cursorDecodeHandler = func(token string) (*PagingCursor, error) {
+			return decodeCursorToken(token, sealer)
+		}
+	}
+}
+
+// WithCursorExpiration : cursor token expiration , 0 is never expire (see DefaultCursorExpiration)
+func WithCursorExpiration(expiration time.Duration) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.cursorExpiration = expiration
+	}
+}
+
+// WithCountStrategy : total records strategy of PagingOptionCollection.Count , nil is exact count (see DefaultCountStrategy)
+func WithCountStrategy(strategy CountStrategy) PaginatorOption {
+	return func(paginator *Paginator) {
+		paginator.countStrategy = strategy
+	}
+}
+
+// WithPageNumberOrderHandler : page number mode order (see DefaultPageNumberOrderHandler)
+func WithPageNumberOrderHandler(handler PageNumberOrderHandler) PaginatorOption {
+	return func(paginator *Paginator) {
+		if handler != nil {
+			paginator.pageNumberOrderHandler = handler
+		}
+	}
+}
+
+// WithCursorColumnCheckHandler : check the cursor columns of the model (see DefaultCursorColumnCheckHandler)
+func WithCursorColumnCheckHandler(handler CursorColumnCheckHandler) PaginatorOption {
+	return func(paginator *Paginator) {
+		if handler != nil {
+			paginator.cursorColumnCheckHandler = handler
+		}
+	}
+}
+
+// WithCursorColumnHandler : the model has the cursor columns (see DefaultCursorColumnHandler) ,
+// used by the cursor column check of the paginator
+func WithCursorColumnHandler(handler CursorColumnHandler) PaginatorOption {
+	return func(paginator *Paginator) {
+		if handler != nil {
+			paginator.cursorColumnHandler = handler
+		}
+	}
+}
+
+// WithCursorOptionCollectionHandler : cursor mode option collection (see DefaultCursorOptionCollectionHandler)
+func WithCursorOptionCollectionHandler(handler CursorOptionCollectionHandler) PaginatorOption {
+	return func(paginator *Paginator) {
+		if handler != nil {
+			paginator.cursorOptionCollectionHandler = handler
+		}
+	}
+}
+
+// WithCalcResultSliceHandler : calc ResultSlice (see DefaultCalcResultSliceHandler)
+func WithCalcResultSliceHandler(handler CalcResultSliceHandler) PaginatorOption {
+	return func(paginator *Paginator) {
+		if handler != nil {
+			paginator.calcResultSliceHandler = handler
+		}
+	}
+}
+
+// WithCursorValuesHandler : typed cursor values of the record (see DefaultCursorValuesHandler)
+func WithCursorValuesHandler(handler CursorValuesHandler) PaginatorOption {
+	return func(paginator *Paginator) {
+		if handler != nil {
+			paginator.cursorValuesHandler = handler
+		}
+	}
+}
+
+// DefaultPagingOption : default paging option of the paginator
+func (paginator *Paginator) DefaultPagingOption() *PagingOption {
+	return &PagingOption{
+		PagingMode:        PagingModeNumber,
+		CurrentPageNumber: defaultCurrentPageNumber,
+		GotoPageNumber:    proto.Int64(defaultGotoPageNumber),
+		PageSize:          proto.Int64(paginator.pageSize),
+		OrderBy:           []*PagingOrder{},
+		CursorColumn:      paginator.orderColumn,
+		CursorDirection:   paginator.orderDirection,
+		CursorValue:       defaultCursorValue,
+	}
+}
+
+// getPageSize paging size of the paginator
+func (paginator *Paginator) getPageSize(pageSize int64) int64 {
+
+	if pageSize < 1 {
+		return paginator.pageSize
+	}
+	return pageSize
+}
+
+// getOrderColumn paging order column of the paginator
+func (paginator *Paginator) getOrderColumn(column string) string {
+
+	if column = strings.TrimSpace(column); column == "" {
+		return paginator.orderColumn
+	}
+	return column
+}
+
+// getOrderDirection paging order direction of the paginator
+func (paginator *Paginator) getOrderDirection(direction string) string {
+
+	if strings.TrimSpace(direction) == "" {
+		return paginator.orderDirection
+	}
+	return getOrderDirection(direction)
+}
+
+// getPaginator the paginator of the option collection
+func (collection *PagingOptionCollection) getPaginator() *Paginator {
+
+	if collection.paginator == nil {
+		return defaultPaginator()
+	}
+	return collection.paginator
+}
+
+// getCursorValuesHandler the cursor values handler of the option collection
+func (collection *PagingOptionCollection) getCursorValuesHandler() CursorValuesHandler {
+
+	if collection.paginator == nil {
+		return DefaultCursorValuesHandler
+	}
+	return collection.paginator.cursorValuesHandler
+}
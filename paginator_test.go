@@ -0,0 +1,179 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// instance defaults && limits
+func TestNewPaginator(t *testing.T) {
+	t.Parallel()
+
+	paginator := NewPaginator(
+		WithPageSize(20),
+		WithOrder("created_at", "asc"),
+		WithLimits(&PagingLimits{PageSize: PagingLimit{Max: 50, Reject: true}}),
+	)
+
+	option := &PagingOption{PagingMode: PagingModeCursor, OrderBy: []*PagingOrder{}}
+	if err := paginator.InitPagingOption(option); err != nil {
+		t.Errorf("\n testing : InitPagingOption error : %v \n", err)
+		return
+	}
+	if option.GetPageSize() != 20 || option.CursorColumn != "created_at" || option.CursorDirection != "asc" {
+		t.Errorf("\n testing : InitPagingOption defaults error : %+v \n", option)
+	}
+
+	// the package-level defaults not changed
+	if option := DefaultPagingOption(); option.GetPageSize() != defaultPageSize || option.CursorColumn != defaultOrderColumn {
+		t.Errorf("\n testing : DefaultPagingOption error : %+v \n", option)
+	}
+
+	option.PageSize = proto.Int64(100)
+	var errs ValidationErrors
+	if _, err := paginator.GetOptionCollection(option); !errors.As(err, &errs) {
+		t.Errorf("\n testing : GetOptionCollection should fail with ValidationErrors : %v \n", err)
+	}
+}
+
+// instance strategies && cursor sealer
+func TestPaginatorStrategies(t *testing.T) {
+	t.Parallel()
+
+	type Model struct {
+		Id int64
+	}
+
+	calls := 0
+	sealed := NewPaginator(
+		WithCursorSealer(MustNewHMACCursorSealer(&CursorKey{ID: "k1", Secret: []byte("0123456789abcdef0123456789abcdef")})),
+		WithCursorValuesHandler(func(optionCollection *PagingOptionCollection, modelStruct interface{}) ([]*PagingCursorValue, error) {
+			calls++
+			return DefaultCursorValuesHandler(optionCollection, modelStruct)
+		}),
+	)
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(2)
+
+	collection, err := sealed.GetOptionCollection(option, &Model{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	// SetPagingResult use the paginator of the option collection
+	result, err := SetPagingResult(collection, &PagingResultCollection{TotalRecords: 10, ResultSlice: []Model{{Id: 10}, {Id: 9}}})
+	if err != nil || calls != 2 || result.NextCursor == "" {
+		t.Errorf("\n testing : SetPagingResult error : %v %d \n", err, calls)
+		return
+	}
+
+	// the sealed token is rejected by the package-level paginator
+	next := DefaultPagingOption()
+	next.Cursor = result.NextCursor
+
+	var cursorErr *CursorError
+	if _, err = GetOptionCollection(next, &Model{}); !errors.As(err, &cursorErr) {
+		t.Errorf("\n testing : GetOptionCollection should fail with CursorError : %v \n", err)
+	}
+
+	next.Cursor = result.NextCursor
+	if _, err = sealed.GetOptionCollection(next, &Model{}); err != nil {
+		t.Errorf("\n testing : sealed GetOptionCollection error : %v \n", err)
+	}
+}
+
+// the cursor column check of the paginator uses the cursor column handler of the paginator
+func TestCursorColumnHandler(t *testing.T) {
+	t.Parallel()
+
+	type Model struct {
+		Id int64
+	}
+
+	calls := 0
+	paginator := NewPaginator(WithCursorColumnHandler(func(pagingOption *PagingOption, model interface{}) (bool, error) {
+		calls++
+		return true, nil
+	}))
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CursorColumn = "virtual_rank"
+
+	if _, err := paginator.GetOptionCollection(option, &Model{}); err != nil || calls != 1 {
+		t.Errorf("\n testing : GetOptionCollection error : %v %d \n", err, calls)
+	}
+
+	// the package-level paginator checks the model fields
+	if _, err := GetOptionCollection(option, &Model{}); err == nil || calls != 1 {
+		t.Errorf("\n testing : GetOptionCollection should fail with the unknown cursor column : %v %d \n", err, calls)
+	}
+
+	// the handler return false : the cursor columns not exist
+	missing := NewPaginator(WithCursorColumnHandler(func(pagingOption *PagingOption, model interface{}) (bool, error) {
+		return false, nil
+	}))
+	if _, err := missing.GetOptionCollection(option, &Model{}); err == nil || err.Error() != "cursorColumn(virtual_rank) not exist in model(table)" {
+		t.Errorf("\n testing : GetOptionCollection should fail with the missing cursor column : %v \n", err)
+	}
+}
+
+// peek mode : limit+1 has next page detection without the total records
+func TestPeekMode(t *testing.T) {
+	t.Parallel()
+
+	type Model struct {
+		Id int64
+	}
+
+	paginator := NewPaginator(WithPeekMode(true))
+
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.PageSize = proto.Int64(3)
+
+	collection, err := paginator.GetOptionCollection(option, &Model{})
+	if err != nil || !collection.Peek || collection.Limit != 4 {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	// the extra record is trimmed
+	records := []Model{{Id: 10}, {Id: 9}, {Id: 8}, {Id: 7}}
+	result, err := paginator.SetPagingResult(collection, &PagingResultCollection{ResultSlice: &records})
+	if err != nil {
+		t.Errorf("\n testing : SetPagingResult error : %v \n", err)
+		return
+	}
+	if len(records) != 3 || !result.HasNextPage || result.HasPreviousPage || result.NextCursor == "" || result.LastPage != 0 {
+		t.Errorf("\n testing : SetPagingResult peek error : %d %+v \n", len(records), result)
+		return
+	}
+
+	// the last page
+	next := DefaultPagingOption()
+	next.Cursor = result.NextCursor
+	if collection, err = paginator.GetOptionCollection(next, &Model{}); err != nil {
+		t.Errorf("\n testing : GetOptionCollection next error : %v \n", err)
+		return
+	}
+
+	records = []Model{{Id: 7}}
+	if result, err = paginator.SetPagingResult(collection, &PagingResultCollection{ResultSlice: &records}); err != nil {
+		t.Errorf("\n testing : SetPagingResult next error : %v \n", err)
+		return
+	}
+	if result.HasNextPage || !result.HasPreviousPage || result.NextCursor != "" || result.PrevCursor == "" {
+		t.Errorf("\n testing : SetPagingResult last page error : %+v \n", result)
+	}
+
+	// ResultSlice must be a slice pointer
+	if _, err = paginator.SetPagingResult(collection, &PagingResultCollection{ResultSlice: records}); err == nil {
+		t.Errorf("\n testing : SetPagingResult should fail with the slice \n")
+	}
+}
@@ -0,0 +1,181 @@
+package pagination
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultSortPolicy : the sort policy of GetOptionCollection (default : nil, no allowlist)
+var DefaultSortPolicy *SortPolicy
+
+// SortPolicy : the sortable column allowlist of an endpoint
+//
+// the client send the public sort key (PagingOrder.Column, CursorColumn, CursorColumns) ,
+// the policy reject the unknown key with ValidationErrors ,
+// and replace the key with the db column in PagingOptionCollection.Order && PagingOptionCollection.Where .
+// the paging option, the paging result and the cursor token keep the public sort key
+//
+// the cursor value of the public sort key is read from the model field (see ModelFieldIndex)
+//
+// the db column of Columns must be a plain column or a table qualified column (example : created_at , u.created_at) ,
+// it is quoted as an identifier (see SQLClause) , Apply returns error of the sql expression .
+// the sql expression (COALESCE(u.updated_at, u.created_at) , lower(u.name) ...) is mapped by Expressions ,
+// the expression is trusted (server-defined, never from the client) and the renderers emit it unquoted
+// (see PagingOptionCollection.IsExpression)
+//
+// example :
+//			policy := pagination.NewSortPolicy(map[string]string{
+//				"id":      "u.id",
+//				"created": "u.created_at",
+//			})
+//			policy.Expressions = map[string]string{"name": "lower(u.name)"}
+//			collection, err := policy.GetOptionCollection(option, &User{})
+type SortPolicy struct {
+	Columns     map[string]string // public sort key => db column (example : created => u.created_at) , quoted
+	Expressions map[string]string // public sort key => trusted sql expression (example : name => lower(u.name)) , not quoted
+}
+
+// sortPolicyColumn plain column or table qualified column
+var sortPolicyColumn = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// NewSortPolicy : sort policy
+func NewSortPolicy(columns map[string]string) *SortPolicy {
+	return &SortPolicy{Columns: columns}
+}
+
+// GetOptionCollection : GetOptionCollection with the sortable column allowlist
+func (policy *SortPolicy) GetOptionCollection(pagingOption *PagingOption, models ...interface{}) (*PagingOptionCollection, error) {
+	return NewPaginator(WithSortPolicy(policy)).GetOptionCollection(pagingOption, models...)
+}
+
+// Apply : check the sort keys of the option collection and replace them with the db columns
+func (policy *SortPolicy) Apply(collection *PagingOptionCollection) error {
+
+	if collection == nil {
+		return fmt.Errorf("PagingOptionCollection cannot be a nil pointer")
+	}
+
+	// the sort keys come from order_by in page number mode , from cursor_columns in cursor mode
+	field := "order_by"
+	if collection.Option != nil && collection.Option.PagingMode == PagingModeCursor {
+		field = "cursor_columns"
+	}
+
+	var errs ValidationErrors
+	for i, order := range collection.Order {
+		if _, _, ok := policy.column(order.Column); !ok {
+			errs = append(errs, &ValidationError{
+				Field:  fmt.Sprintf("%s[%d].column", field, i),
+				Value:  order.Column,
+				Reason: fmt.Sprintf("sort key(%s) not allowed", order.Column),
+			})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	// the where columns are the cursor columns
+	if err := policy.checkWhere(collection.Where, &errs); err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	// public sort key => db column || sql expression
+	order := make([]*PagingOrder, 0, len(collection.Order))
+	for _, orderBy := range collection.Order {
+		column, isExpression, _ := policy.column(orderBy.Column)
+		if isExpression {
+			collection.addExpression(column)
+		} else if err := checkPolicyColumn(orderBy.Column, column); err != nil {
+			return err
+		}
+		order = append(order, &PagingOrder{Column: column, Direction: orderBy.Direction})
+	}
+	collection.Order = order
+	collection.Where = policy.mapWhere(collection, collection.Where)
+
+	return nil
+}
+
+// column the db column (Columns) or the sql expression (Expressions) of the public sort key
+func (policy *SortPolicy) column(key string) (column string, isExpression bool, ok bool) {
+
+	key = strings.TrimSpace(key)
+	if column, ok = policy.Columns[key]; ok && strings.TrimSpace(column) != "" {
+		return column, false, true
+	}
+	if column, ok = policy.Expressions[key]; ok && strings.TrimSpace(column) != "" {
+		return column, true, true
+	}
+	return "", false, false
+}
+
+// checkWhere the where column must be a sort key
+func (policy *SortPolicy) checkWhere(wheres []*PagingWhere, errs *ValidationErrors) error {
+
+	for _, where := range wheres {
+		if where == nil {
+			return fmt.Errorf("PagingWhere cannot be a nil pointer")
+		}
+		if len(where.Conditions) > 0 {
+			if err := policy.checkWhere(where.Conditions, errs); err != nil {
+				return err
+			}
+			continue
+		}
+		column, isExpression, ok := policy.column(where.Column)
+		if !ok {
+			*errs = append(*errs, &ValidationError{
+				Field:  "cursor_columns",
+				Value:  where.Column,
+				Reason: fmt.Sprintf("sort key(%s) not allowed", where.Column),
+			})
+			continue
+		}
+		if isExpression {
+			continue
+		}
+		if err := checkPolicyColumn(where.Column, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPolicyColumn the db column of the policy must be a plain column (the sql expression is mapped by Expressions)
+func checkPolicyColumn(key, column string) error {
+
+	if !sortPolicyColumn.MatchString(strings.TrimSpace(column)) {
+		return fmt.Errorf("sort policy column(%s) of sort key(%s) must be a plain column (example : u.created_at) , "+
+			"map the sql expression by SortPolicy.Expressions", column, key)
+	}
+	return nil
+}
+
+// mapWhere copy the where with the db columns || sql expressions
+func (policy *SortPolicy) mapWhere(collection *PagingOptionCollection, wheres []*PagingWhere) []*PagingWhere {
+
+	if wheres == nil {
+		return nil
+	}
+
+	mapped := make([]*PagingWhere, 0, len(wheres))
+	for _, where := range wheres {
+		where := *where
+		if len(where.Conditions) > 0 {
+			where.Conditions = policy.mapWhere(collection, where.Conditions)
+		} else {
+			column, isExpression, _ := policy.column(where.Column)
+			if isExpression {
+				collection.addExpression(column)
+			}
+			where.Column = column
+		}
+		mapped = append(mapped, &where)
+	}
+	return mapped
+}
@@ -0,0 +1,114 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// sortable column allowlist
+func TestSortPolicy(t *testing.T) {
+	type User struct {
+		Id      int64
+		Created int64 `paging:"created"`
+	}
+
+	policy := NewSortPolicy(map[string]string{
+		"id":      "u.id",
+		"created": "u.created_at",
+	})
+
+	// page number mode
+	option := DefaultPagingOption()
+	option.OrderBy = []*PagingOrder{{Column: "created", Direction: "asc"}, {Column: "id"}}
+
+	collection, err := policy.GetOptionCollection(option, &User{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+	if collection.Order[0].Column != "u.created_at" || collection.Order[1].Column != "u.id" || option.OrderBy[0].Column != "created" {
+		t.Errorf("\n testing : SortPolicy order error : %+v \n", collection.Order)
+	}
+
+	// unknown sort key
+	option.OrderBy = []*PagingOrder{{Column: "id"}, {Column: "id; DROP TABLE users"}}
+
+	_, err = policy.GetOptionCollection(option, &User{})
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field != "order_by[1].column" {
+		t.Errorf("\n testing : GetOptionCollection should fail with ValidationErrors : %v \n", err)
+	}
+
+	// cursor mode
+	option = DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CursorColumns = []*PagingOrder{{Column: "created", Direction: "desc"}, {Column: "id", Direction: "desc"}}
+	option.CursorValues = []float64{1613577600, 7}
+	option.CurrentPageNumber = 1
+	option.GotoPageNumber = proto.Int64(2)
+
+	collection, err = policy.GetOptionCollection(option, &User{})
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection cursor error : %v \n", err)
+		return
+	}
+
+	where, _ := collection.Where[0].Expression()
+	if where != "(u.created_at < ? OR (u.created_at = ? AND u.id < ?))" || collection.Order[0].Column != "u.created_at" {
+		t.Errorf("\n testing : SortPolicy where error : %s \n", where)
+	}
+
+	// the cursor token keep the public sort key
+	result, err := SetPagingResult(collection, &PagingResultCollection{TotalRecords: 100, ResultSlice: []User{{Id: 6, Created: 1613577600}}})
+	if err != nil || result.CursorColumns[0].Column != "created" {
+		t.Errorf("\n testing : SetPagingResult error : %v \n", err)
+		return
+	}
+
+	next := DefaultPagingOption()
+	next.Cursor = result.NextCursor
+	if _, err = policy.GetOptionCollection(next, &User{}); err != nil {
+		t.Errorf("\n testing : GetOptionCollection next_cursor error : %v \n", err)
+	}
+
+	// the sql expression of Columns is not a db column
+	expressionPolicy := NewSortPolicy(map[string]string{"id": "u.id", "name": "lower(u.name)"})
+	expressionOption := DefaultPagingOption()
+	expressionOption.OrderBy = []*PagingOrder{{Column: "name"}}
+
+	if _, err = expressionPolicy.GetOptionCollection(expressionOption, &User{}); err == nil || errors.As(err, &errs) {
+		t.Errorf("\n testing : GetOptionCollection should fail with the sql expression : %v \n", err)
+	}
+
+	// the trusted sql expression of Expressions : not quoted , the db column is quoted
+	expressionPolicy = NewSortPolicy(map[string]string{"id": "u.id"})
+	expressionPolicy.Expressions = map[string]string{"name": "lower(u.name)"}
+	expressionOption = DefaultPagingOption()
+	expressionOption.PagingMode = PagingModeCursor
+	expressionOption.CursorColumns = []*PagingOrder{{Column: "name", Direction: "asc"}, {Column: "id", Direction: "asc"}}
+	expressionOption.CursorTypedValues = []*PagingCursorValue{{Value: &PagingCursorValue_StringValue{StringValue: "bob"}}, {Value: &PagingCursorValue_IntValue{IntValue: 7}}}
+	expressionOption.CurrentPageNumber = 1
+	expressionOption.GotoPageNumber = proto.Int64(2)
+
+	collection, err = expressionPolicy.GetOptionCollection(expressionOption)
+	if err != nil || !collection.IsExpression("lower(u.name)") || collection.IsExpression("u.id") {
+		t.Errorf("\n testing : GetOptionCollection expression error : %v %+v \n", err, collection)
+		return
+	}
+	clause, err := RenderSQL(collection, DialectPostgres)
+	want := `WHERE (lower(u.name) > $1 OR (lower(u.name) = $2 AND "u"."id" > $3)) ORDER BY lower(u.name) ASC, "u"."id" ASC LIMIT 15 OFFSET 0`
+	if err != nil || clause.String() != want {
+		t.Errorf("\n testing : RenderSQL expression error : %v \n got  %s \n want %s \n", err, clause, want)
+	}
+
+	// DefaultSortPolicy
+	defer func() { DefaultSortPolicy = nil }()
+	DefaultSortPolicy = NewSortPolicy(map[string]string{"id": "id"})
+
+	if _, err = GetOptionCollection(option, &User{}); !errors.As(err, &errs) || errs[0].Field != "cursor_columns[0].column" {
+		t.Errorf("\n testing : GetOptionCollection should fail with DefaultSortPolicy : %v \n", err)
+	}
+}
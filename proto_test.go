@@ -0,0 +1,131 @@
+package pagination
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// the encoding of the legacy github.com/golang/protobuf (apiv1) generated code
+var (
+	legacyPagingOptionHex = "08021002a00603a80614c20c120a0a637265617465645f6174120464657363c20c090a0269641203617363e212026964" +
+		"ea120464657363f112000000000000f8bffa12120a0a637265617465645f6174120464657363fa120a0a026964120464" +
+		"657363821310000000a0870bd8410000000000001c408a130a65794a32496a6f79665192130b08f9ffffffffffffffff" +
+		"0192130b108080808080808080800192130919000000000000d03f9213042202676f9213162a14323032312d30322d31" +
+		"385430383a30303a30305a9213123210123456789abcdef0123456789abcdef09213073a0531322e33309213024001"
+	legacyPagingResultHex = "0802a00619a8060ab00602b8060bc00614c80603d00601d80601e00601c20c0a0a026964120464657363e212026964ea" +
+		"120464657363f1120000000000002640fa120a0a02696412046465736382130800000000000026408a13046e65787492" +
+		"1304707265769a1302080ba213057374617274aa1303656e64b21304726f7731b21304726f77328219ef0108021002a0" +
+		"0603a80614c20c120a0a637265617465645f6174120464657363c20c090a0269641203617363e212026964ea12046465" +
+		"7363f112000000000000f8bffa12120a0a637265617465645f6174120464657363fa120a0a0269641204646573638213" +
+		"10000000a0870bd8410000000000001c408a130a65794a32496a6f79665192130b08f9ffffffffffffffff0192130b10" +
+		"8080808080808080800192130919000000000000d03f9213042202676f9213162a14323032312d30322d31385430383a" +
+		"30303a30305a9213123210123456789abcdef0123456789abcdef09213073a0531322e33309213024001"
+	legacyPageTokenRequestHex  = "08141205746f6b656e1a0a6e616d65203d202261222213637265617465645f617420646573632c206964"
+	legacyPageTokenResponseHex = "0a046e6578741019"
+)
+
+// testProtoPagingOption every field of the paging option
+func testProtoPagingOption() *PagingOption {
+	return &PagingOption{
+		PagingMode:        PagingModeCursor,
+		CurrentPageNumber: 2,
+		GotoPageNumber:    proto.Int64(3),
+		PageSize:          proto.Int64(20),
+		OrderBy:           []*PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "id", Direction: "asc"}},
+		CursorColumn:      "id",
+		CursorDirection:   "desc",
+		CursorValue:       -1.5,
+		CursorColumns:     []*PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "id", Direction: "desc"}},
+		CursorValues:      []float64{1613635200, 7},
+		Cursor:            "eyJ2IjoyfQ",
+		CursorTypedValues: []*PagingCursorValue{
+			{Value: &PagingCursorValue_IntValue{IntValue: -7}},
+			{Value: &PagingCursorValue_UintValue{UintValue: 1 << 63}},
+			{Value: &PagingCursorValue_DoubleValue{DoubleValue: 0.25}},
+			{Value: &PagingCursorValue_StringValue{StringValue: "go"}},
+			{Value: &PagingCursorValue_TimeValue{TimeValue: "2021-02-18T08:00:00Z"}},
+			{Value: &PagingCursorValue_UuidValue{UuidValue: []byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}}},
+			{Value: &PagingCursorValue_DecimalValue{DecimalValue: "12.30"}},
+			{Value: &PagingCursorValue_NullValue{NullValue: true}},
+		},
+	}
+}
+
+// testProtoPagingResult every field of the paging result
+func testProtoPagingResult() *PagingResult {
+	return &PagingResult{
+		PagingMode:           PagingModeCursor,
+		TotalSize:            25,
+		PageSize:             10,
+		CurrentPage:          2,
+		ShowFrom:             11,
+		ShowTo:               20,
+		LastPage:             3,
+		HasNextPage:          true,
+		HasPreviousPage:      true,
+		TotalSizeApproximate: true,
+		OrderBy:              []*PagingOrder{{Column: "id", Direction: "desc"}},
+		CursorColumn:         "id",
+		CursorDirection:      "desc",
+		CursorValue:          11,
+		CursorColumns:        []*PagingOrder{{Column: "id", Direction: "desc"}},
+		CursorValues:         []float64{11},
+		NextCursor:           "next",
+		PrevCursor:           "prev",
+		CursorTypedValues:    []*PagingCursorValue{{Value: &PagingCursorValue_IntValue{IntValue: 11}}},
+		StartCursor:          "start",
+		EndCursor:            "end",
+		RowCursors:           []string{"row1", "row2"},
+		Option:               testProtoPagingOption(),
+	}
+}
+
+// the apiv2 messages && the legacy encoding : unmarshal the legacy bytes , marshal the same bytes
+func TestProtoLegacyEncoding(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name    string
+		legacy  string
+		message proto.Message
+		empty   proto.Message
+	}{
+		{name: "paging_option", legacy: legacyPagingOptionHex, message: testProtoPagingOption(), empty: &PagingOption{}},
+		{name: "paging_result", legacy: legacyPagingResultHex, message: testProtoPagingResult(), empty: &PagingResult{}},
+		{name: "page_token_request", legacy: legacyPageTokenRequestHex, message: &PageTokenRequest{PageSize: 20, PageToken: "token", Filter: `name = "a"`, OrderBy: "created_at desc, id"}, empty: &PageTokenRequest{}},
+		{name: "page_token_response", legacy: legacyPageTokenResponseHex, message: &PageTokenResponse{NextPageToken: "next", TotalSize: 25}, empty: &PageTokenResponse{}},
+	} {
+		legacy, err := hex.DecodeString(testCase.legacy)
+		if err != nil {
+			t.Errorf("\n testing : %s hex error : %v \n", testCase.name, err)
+			continue
+		}
+
+		// legacy => apiv2
+		if err := proto.Unmarshal(legacy, testCase.empty); err != nil || !proto.Equal(testCase.empty, testCase.message) {
+			t.Errorf("\n testing : %s unmarshal error : %v \n", testCase.name, err)
+			continue
+		}
+
+		// apiv2 => legacy
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(testCase.message)
+		if err != nil || hex.EncodeToString(data) != testCase.legacy {
+			t.Errorf("\n testing : %s marshal error : %v \n %x \n", testCase.name, err, data)
+			continue
+		}
+
+		// protojson
+		data, err = protojson.Marshal(testCase.message)
+		if err != nil {
+			t.Errorf("\n testing : %s protojson error : %v \n", testCase.name, err)
+			continue
+		}
+		message := testCase.message.ProtoReflect().New().Interface()
+		if err := protojson.Unmarshal(data, message); err != nil || !proto.Equal(message, testCase.message) {
+			t.Errorf("\n testing : %s protojson round trip error : %v \n %s \n", testCase.name, err, data)
+		}
+	}
+}
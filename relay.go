@@ -0,0 +1,155 @@
+package pagination
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ConnectionArgs : relay cursor connection arguments , first && after (forward) or last && before (backward)
+//
+// the zero value is the unset argument
+type ConnectionArgs struct {
+	First  int64  `json:"first,omitempty"`  // the first records after the cursor
+	After  string `json:"after,omitempty"`  // the cursor of the edge
+	Last   int64  `json:"last,omitempty"`   // the last records before the cursor
+	Before string `json:"before,omitempty"` // the cursor of the edge
+}
+
+// Connection : relay cursor connection
+type Connection[T any] struct {
+	Edges      []*Edge[T] `json:"edges"`      // edges
+	PageInfo   *PageInfo  `json:"pageInfo"`   // page info
+	TotalCount int64      `json:"totalCount"` // total records (0 : unknown in peek mode)
+}
+
+// Edge : relay cursor connection edge
+type Edge[T any] struct {
+	Node   T      `json:"node"`   // the record
+	Cursor string `json:"cursor"` // the cursor of the record
+}
+
+// PageInfo : relay cursor connection page info
+type PageInfo struct {
+	HasNextPage     bool   `json:"hasNextPage"`     // has the next page
+	HasPreviousPage bool   `json:"hasPreviousPage"` // has the preceding page
+	StartCursor     string `json:"startCursor"`     // the cursor of the first edge
+	EndCursor       string `json:"endCursor"`       // the cursor of the last edge
+}
+
+// ConnectionOption : relay connection arguments => cursor mode paging option
+//
+// example :
+//			option, err := pagination.ConnectionOption(&pagination.ConnectionArgs{First: 10, After: after})
+//			collection, err := pagination.GetOptionCollection(option, &User{})
+//			// query users with the collection
+//			result, err := pagination.SetPagingResult(collection, &pagination.PagingResultCollection{...})
+//			connection, err := pagination.NewConnection(collection, users, result)
+func ConnectionOption(args *ConnectionArgs) (*PagingOption, error) {
+	return defaultPaginator().ConnectionOption(args)
+}
+
+// ConnectionOption : relay connection arguments => cursor mode paging option of the paginator
+func (paginator *Paginator) ConnectionOption(args *ConnectionArgs) (*PagingOption, error) {
+
+	if args == nil {
+		return nil, fmt.Errorf("ConnectionArgs cannot be a nil pointer")
+	}
+
+	var errs ValidationErrors
+	invalid := func(field string, value interface{}, reason string) {
+		errs = append(errs, &ValidationError{Field: field, Value: fmt.Sprint(value), Reason: reason})
+	}
+	if args.First < 0 {
+		invalid("first", args.First, "must be greater than or equal to 0")
+	}
+	if args.Last < 0 {
+		invalid("last", args.Last, "must be greater than or equal to 0")
+	}
+	if args.First > 0 && args.Last > 0 {
+		invalid("last", args.Last, "cannot be used with first")
+	}
+	if args.After != "" && args.Before != "" {
+		invalid("before", args.Before, "cannot be used with after")
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	pagingOption := paginator.DefaultPagingOption()
+	pagingOption.PagingMode = PagingModeCursor
+
+	// forward : first && after
+	if args.Last == 0 && args.Before == "" {
+		pagingOption.PageSize = proto.Int64(paginator.getPageSize(args.First))
+		pagingOption.Cursor = args.After
+		return pagingOption, nil
+	}
+
+	// backward : last && before
+	pagingOption.PageSize = proto.Int64(paginator.getPageSize(args.Last))
+
+	// the last page : the backward cursor without values
+	cursor := &PagingCursor{Columns: getCursorColumns(pagingOption), Page: 1}
+	if args.Before != "" {
+		before, err := paginator.DecodeCursor(args.Before)
+		if err != nil {
+			return nil, err
+		}
+
+		// the edge cursor is the page after the edge
+		cursor.Columns, cursor.Values, cursor.Page = before.Columns, before.Values, before.Page-2
+		if cursor.Page < 1 {
+			cursor.Page = 1
+		}
+	}
+	cursor.Backward = true
+
+	token, err := paginator.EncodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	pagingOption.Cursor = token
+	return pagingOption, nil
+}
+
+// NewConnection : the records && paging result => relay cursor connection ,
+// every edge has the cursor of the record (the records after the record)
+//
+// the page info is the paging result (has_next_page, has_previous_page) , use the peek mode (WithPeekMode)
+// to know the preceding page of last && before exactly
+func NewConnection[T any](optionCollection *PagingOptionCollection, items []T, pagingResult *PagingResult) (*Connection[T], error) {
+
+	if optionCollection == nil || pagingResult == nil {
+		return nil, fmt.Errorf("PagingOptionCollection && PagingResult cannot be a nil pointer")
+	}
+	if optionCollection.Option.PagingMode != PagingModeCursor {
+		return nil, fmt.Errorf("relay connection need cursor mode")
+	}
+
+	// the cursor token of every record (PagingResult.RowCursors of WithRowCursors)
+	cursors := pagingResult.RowCursors
+	if len(cursors) != len(items) {
+		var err error
+		if cursors, err = optionCollection.getPaginator().getRowCursors(optionCollection, pagingResult, items); err != nil {
+			return nil, err
+		}
+	}
+
+	connection := &Connection[T]{
+		Edges: make([]*Edge[T], 0, len(items)),
+		PageInfo: &PageInfo{
+			HasNextPage:     pagingResult.HasNextPage,
+			HasPreviousPage: pagingResult.HasPreviousPage,
+		},
+		TotalCount: pagingResult.TotalSize,
+	}
+	for i := range items {
+		connection.Edges = append(connection.Edges, &Edge[T]{Node: items[i], Cursor: cursors[i]})
+	}
+	if len(cursors) > 0 {
+		connection.PageInfo.StartCursor = cursors[0]
+		connection.PageInfo.EndCursor = cursors[len(cursors)-1]
+	}
+	return connection, nil
+}
@@ -0,0 +1,113 @@
+package pagination
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+)
+
+// relayModel relay test model
+type relayModel struct {
+	Id int64 `json:"id"`
+}
+
+// queryRelayModels id 1 ~ 10 with the single column option collection
+func queryRelayModels(collection *PagingOptionCollection) []relayModel {
+	var records []relayModel
+	for id := int64(1); id <= 10; id++ {
+		matched := true
+		for _, where := range collection.Where {
+			data := where.Data.(int64)
+			switch where.Symbol {
+			case "<":
+				matched = matched && id < data
+			case ">":
+				matched = matched && id > data
+			}
+		}
+		if matched {
+			records = append(records, relayModel{Id: id})
+		}
+	}
+
+	asc := collection.Order[0].Direction == "asc"
+	sort.Slice(records, func(i, j int) bool { return (records[i].Id < records[j].Id) == asc })
+
+	if int64(len(records)) > collection.Limit {
+		records = records[:collection.Limit]
+	}
+	return records
+}
+
+// relay connection : first/after && last/before
+func TestConnection(t *testing.T) {
+	t.Parallel()
+
+	paginator := NewPaginator(WithPeekMode(true))
+
+	connection := func(args *ConnectionArgs) (*Connection[relayModel], error) {
+		option, err := paginator.ConnectionOption(args)
+		if err != nil {
+			return nil, err
+		}
+		collection, err := paginator.GetOptionCollection(option, &relayModel{})
+		if err != nil {
+			return nil, err
+		}
+		records := queryRelayModels(collection)
+		result, err := paginator.SetPagingResult(collection, &PagingResultCollection{ResultSlice: &records})
+		if err != nil {
+			return nil, err
+		}
+		return NewConnection(collection, records, result)
+	}
+	nodes := func(connection *Connection[relayModel]) string {
+		var ids []int64
+		for _, edge := range connection.Edges {
+			ids = append(ids, edge.Node.Id)
+		}
+		return fmt.Sprint(ids)
+	}
+
+	// first 3 : 10 9 8
+	first, err := connection(&ConnectionArgs{First: 3})
+	if err != nil || nodes(first) != "[10 9 8]" || !first.PageInfo.HasNextPage || first.PageInfo.HasPreviousPage || first.PageInfo.EndCursor != first.Edges[2].Cursor {
+		t.Errorf("\n testing : first error : %v %+v \n", err, first)
+		return
+	}
+
+	// first 3 after 9 : 8 7 6
+	after, err := connection(&ConnectionArgs{First: 3, After: first.Edges[1].Cursor})
+	if err != nil || nodes(after) != "[8 7 6]" || !after.PageInfo.HasNextPage || !after.PageInfo.HasPreviousPage {
+		t.Errorf("\n testing : after error : %v %+v \n", err, after)
+		return
+	}
+
+	// last 2 before 6 : 8 7
+	before, err := connection(&ConnectionArgs{Last: 2, Before: after.PageInfo.EndCursor})
+	if err != nil || nodes(before) != "[8 7]" || !before.PageInfo.HasNextPage || !before.PageInfo.HasPreviousPage {
+		t.Errorf("\n testing : before error : %v %+v \n", err, before)
+		return
+	}
+
+	// last 3 : 3 2 1
+	last, err := connection(&ConnectionArgs{Last: 3})
+	if err != nil || nodes(last) != "[3 2 1]" || last.PageInfo.HasNextPage || !last.PageInfo.HasPreviousPage {
+		t.Errorf("\n testing : last error : %v %+v \n", err, last)
+		return
+	}
+
+	// edges && pageInfo
+	data, _ := json.Marshal(last)
+	if want := fmt.Sprintf(`{"edges":[{"node":{"id":3},"cursor":"%s"}`, last.Edges[0].Cursor); string(data[:len(want)]) != want {
+		t.Errorf("\n testing : json error : %s \n", data)
+	}
+
+	// first && last
+	var errs ValidationErrors
+	if _, err = paginator.ConnectionOption(&ConnectionArgs{First: 1, Last: 1}); !errors.As(err, &errs) || errs[0].Field != "last" {
+		t.Errorf("\n testing : ConnectionOption should fail with ValidationErrors : %v \n", err)
+	}
+}
@@ -0,0 +1,284 @@
+package pagination
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// sql dialect
+const (
+	DialectMySQL     = "mysql"     // sql dialect : mysql
+	DialectPostgres  = "postgres"  // sql dialect : postgresql
+	DialectSQLite    = "sqlite"    // sql dialect : sqlite
+	DialectSQLServer = "sqlserver" // sql dialect : sql server 2012+
+	DialectOracle    = "oracle"    // sql dialect : oracle 12c+
+)
+
+// SQLClause : sql clause of PagingOptionCollection
+//
+// example : mysql
+//			Where   : (`created_at` < ? OR (`created_at` = ? AND `id` < ?))
+//			OrderBy : `created_at` DESC, `id` DESC
+//			Limit   : LIMIT 10 OFFSET 0
+//			Args    : [t, t, 1]
+type SQLClause struct {
+	Where   string        // where clause without WHERE
+	OrderBy string        // order clause without ORDER BY
+	Limit   string        // limit clause (example : LIMIT 10 OFFSET 0 ; OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY)
+	Args    []interface{} // where args
+}
+
+// String : WHERE ... ORDER BY ... LIMIT ...
+func (clause *SQLClause) String() string {
+
+	var clauses []string
+	if clause.Where != "" {
+		clauses = append(clauses, "WHERE "+clause.Where)
+	}
+	if clause.OrderBy != "" {
+		clauses = append(clauses, "ORDER BY "+clause.OrderBy)
+	}
+	if clause.Limit != "" {
+		clauses = append(clauses, clause.Limit)
+	}
+	return strings.Join(clauses, " ")
+}
+
+// SQLRenderer : render PagingOptionCollection to sql clause
+type SQLRenderer struct {
+	Dialect          string // sql dialect (default : mysql)
+	PlaceholderStart int    // the first placeholder number ($n, @pn, :n), after the args of the base query (default : 1)
+}
+
+// NewSQLRenderer : sql renderer
+func NewSQLRenderer(dialect string) *SQLRenderer {
+	return &SQLRenderer{Dialect: dialect, PlaceholderStart: 1}
+}
+
+// RenderSQL : render PagingOptionCollection to sql clause
+func RenderSQL(collection *PagingOptionCollection, dialect string) (*SQLClause, error) {
+	return NewSQLRenderer(dialect).Render(collection)
+}
+
+// Render : render PagingOptionCollection to sql clause
+func (renderer *SQLRenderer) Render(collection *PagingOptionCollection) (*SQLClause, error) {
+
+	if collection == nil {
+		return nil, fmt.Errorf("PagingOptionCollection cannot be a nil pointer")
+	}
+
+	dialect, err := getSQLDialect(renderer.Dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	placeholderStart := renderer.PlaceholderStart
+	if placeholderStart < 1 {
+		placeholderStart = 1
+	}
+
+	clause := &SQLClause{Args: []interface{}{}}
+
+	// where
+	var wheres []string
+	for _, where := range collection.Where {
+		expression, err := renderer.renderWhere(dialect, collection, where, placeholderStart, clause)
+		if err != nil {
+			return nil, err
+		}
+		wheres = append(wheres, expression)
+	}
+	clause.Where = strings.Join(wheres, " AND ")
+
+	// order by
+	var orders []string
+	for _, order := range collection.Order {
+		column, err := renderSQLColumn(dialect, collection, order.Column)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, column+" "+strings.ToUpper(getOrderDirection(order.Direction)))
+	}
+	clause.OrderBy = strings.Join(orders, ", ")
+
+	// limit && offset
+	clause.Limit = renderSQLLimit(dialect, collection.Limit, collection.Offset)
+
+	// sql server : OFFSET FETCH need ORDER BY
+	if dialect == DialectSQLServer && clause.OrderBy == "" && clause.Limit != "" {
+		clause.OrderBy = "(SELECT NULL)"
+	}
+	return clause, nil
+}
+
+// renderWhere where expression && args
+func (renderer *SQLRenderer) renderWhere(dialect string, collection *PagingOptionCollection, where *PagingWhere, placeholderStart int, clause *SQLClause) (string, error) {
+
+	if where == nil {
+		return "", fmt.Errorf("PagingWhere cannot be a nil pointer")
+	}
+
+	// grouped conditions
+	if len(where.Conditions) > 0 {
+		logic := strings.ToUpper(strings.TrimSpace(where.Logic))
+		if logic != PagingWhereAnd && logic != PagingWhereOr {
+			return "", fmt.Errorf("PagingWhere logic(%s) not supported", where.Logic)
+		}
+
+		var expressions []string
+		for _, condition := range where.Conditions {
+			expression, err := renderer.renderWhere(dialect, collection, condition, placeholderStart, clause)
+			if err != nil {
+				return "", err
+			}
+			expressions = append(expressions, expression)
+		}
+		return "(" + strings.Join(expressions, " "+logic+" ") + ")", nil
+	}
+
+	// condition
+	column, err := renderSQLColumn(dialect, collection, where.Column)
+	if err != nil {
+		return "", err
+	}
+
+	symbol := strings.TrimSpace(where.Symbol)
+	switch symbol {
+	case "=", "<>", "!=", "<", "<=", ">", ">=":
+	default:
+		return "", fmt.Errorf("PagingWhere symbol(%s) not supported", where.Symbol)
+	}
+
+	clause.Args = append(clause.Args, where.Data)
+	return column + " " + symbol + " " + renderSQLPlaceholder(dialect, placeholderStart+len(clause.Args)-1), nil
+}
+
+// getSQLDialect sql dialect
+func getSQLDialect(dialect string) (string, error) {
+
+	switch strings.ToLower(strings.TrimSpace(dialect)) {
+
+	case "", DialectMySQL:
+		return DialectMySQL, nil
+
+	case DialectPostgres, "postgresql", "pgx":
+		return DialectPostgres, nil
+
+	case DialectSQLite, "sqlite3":
+		return DialectSQLite, nil
+
+	case DialectSQLServer, "mssql":
+		return DialectSQLServer, nil
+
+	case DialectOracle, "godror", "oci8":
+		return DialectOracle, nil
+
+	default:
+		return "", fmt.Errorf("sql dialect(%s) not supported", dialect)
+	}
+}
+
+// renderSQLPlaceholder where placeholder : mysql && sqlite(?) ; postgres($n) ; sql server(@pn) ; oracle(:n)
+func renderSQLPlaceholder(dialect string, n int) string {
+
+	switch dialect {
+
+	case DialectPostgres:
+		return "$" + strconv.Itoa(n)
+
+	case DialectSQLServer:
+		return "@p" + strconv.Itoa(n)
+
+	case DialectOracle:
+		return ":" + strconv.Itoa(n)
+
+	default:
+		return defaultWherePlaceholder
+	}
+}
+
+// renderSQLLimit limit && offset
+//
+// mysql && postgres && sqlite : LIMIT 10 OFFSET 20
+// sql server : OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY
+// oracle : FETCH FIRST 10 ROWS ONLY ; OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY
+func renderSQLLimit(dialect string, limit, offset int64) string {
+
+	if limit < 1 && offset < 1 {
+		return ""
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	switch dialect {
+
+	case DialectSQLServer:
+		if limit < 1 {
+			return fmt.Sprintf("OFFSET %d ROWS", offset)
+		}
+		return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", offset, limit)
+
+	case DialectOracle:
+		if limit < 1 {
+			return fmt.Sprintf("OFFSET %d ROWS", offset)
+		}
+		if offset == 0 {
+			return fmt.Sprintf("FETCH FIRST %d ROWS ONLY", limit)
+		}
+		return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", offset, limit)
+
+	default:
+		if limit < 1 {
+			// mysql need a limit with offset
+			return fmt.Sprintf("LIMIT %d OFFSET %d", int64(math.MaxInt64), offset)
+		}
+		return fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset)
+	}
+}
+
+// renderSQLColumn the trusted sql expression (SortPolicy.Expressions) unquoted , or the quoted column
+func renderSQLColumn(dialect string, collection *PagingOptionCollection, column string) (string, error) {
+
+	if collection.IsExpression(column) {
+		return column, nil
+	}
+	return quoteSQLIdentifier(dialect, column)
+}
+
+// quoteSQLIdentifier quote column (example : t.created_at)
+//
+// mysql : `t`.`created_at` ; postgres : "t"."created_at" ; sqlite : "t"."created_at" ;
+// sql server : [t].[created_at] ; oracle : "t"."created_at"
+//
+// the identifier is quoted as given , the quoted identifier is case-sensitive in postgres && oracle ,
+// use the stored case of the column (oracle : CREATED_AT for the unquoted created_at of the DDL)
+func quoteSQLIdentifier(dialect, identifier string) (string, error) {
+
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return "", fmt.Errorf("sql identifier cannot be empty")
+	}
+
+	parts := strings.Split(identifier, ".")
+	for i, part := range parts {
+		if part == "" {
+			return "", fmt.Errorf("sql identifier(%s) invalid", identifier)
+		}
+
+		switch dialect {
+
+		case DialectMySQL:
+			parts[i] = "`" + strings.Replace(part, "`", "``", -1) + "`"
+
+		case DialectSQLServer:
+			parts[i] = "[" + strings.Replace(part, "]", "]]", -1) + "]"
+
+		default:
+			parts[i] = `"` + strings.Replace(part, `"`, `""`, -1) + `"`
+		}
+	}
+	return strings.Join(parts, "."), nil
+}
@@ -0,0 +1,84 @@
+package pagination
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// render sql clause
+func TestRenderSQL(t *testing.T) {
+	option := DefaultPagingOption()
+	option.PagingMode = PagingModeCursor
+	option.CurrentPageNumber = 1
+	option.GotoPageNumber = proto.Int64(3)
+	option.PageSize = proto.Int64(10)
+	option.CursorColumns = []*PagingOrder{{Column: "created_at", Direction: "desc"}, {Column: "id", Direction: "desc"}}
+	option.CursorValues = []float64{100, 7}
+
+	collection, err := GetOptionCollection(option)
+	if err != nil {
+		t.Errorf("\n testing : GetOptionCollection error : %v \n", err)
+		return
+	}
+
+	tests := map[string]string{
+		DialectMySQL:     "WHERE (`created_at` < ? OR (`created_at` = ? AND `id` < ?)) ORDER BY `created_at` DESC, `id` DESC LIMIT 10 OFFSET 10",
+		DialectPostgres:  `WHERE ("created_at" < $1 OR ("created_at" = $2 AND "id" < $3)) ORDER BY "created_at" DESC, "id" DESC LIMIT 10 OFFSET 10`,
+		DialectSQLite:    `WHERE ("created_at" < ? OR ("created_at" = ? AND "id" < ?)) ORDER BY "created_at" DESC, "id" DESC LIMIT 10 OFFSET 10`,
+		DialectSQLServer: `WHERE ([created_at] < @p1 OR ([created_at] = @p2 AND [id] < @p3)) ORDER BY [created_at] DESC, [id] DESC OFFSET 10 ROWS FETCH NEXT 10 ROWS ONLY`,
+		DialectOracle:    `WHERE ("created_at" < :1 OR ("created_at" = :2 AND "id" < :3)) ORDER BY "created_at" DESC, "id" DESC OFFSET 10 ROWS FETCH NEXT 10 ROWS ONLY`,
+	}
+
+	for dialect, want := range tests {
+		clause, err := RenderSQL(collection, dialect)
+		if err != nil {
+			t.Errorf("\n testing : RenderSQL(%s) error : %v \n", dialect, err)
+			continue
+		}
+		if clause.String() != want || len(clause.Args) != 3 {
+			t.Errorf("\n testing : RenderSQL(%s) error : \n got  %s \n want %s \n", dialect, clause.String(), want)
+		}
+	}
+
+	// placeholder start : after the args of the base query
+	renderer := NewSQLRenderer(DialectPostgres)
+	renderer.PlaceholderStart = 3
+	clause, err := renderer.Render(collection)
+	if err != nil || clause.Where != `("created_at" < $3 OR ("created_at" = $4 AND "id" < $5))` {
+		t.Errorf("\n testing : SQLRenderer.Render error : %v %s \n", err, clause.Where)
+	}
+
+	// first page : oracle FETCH FIRST , sql server ORDER BY (SELECT NULL)
+	collection = defaultPaginator().getNumberOptionCollection(DefaultPagingOption())
+	if clause, _ = RenderSQL(collection, DialectOracle); clause.String() != "FETCH FIRST 15 ROWS ONLY" {
+		t.Errorf("\n testing : RenderSQL(oracle) first page error : %s \n", clause.String())
+	}
+	if clause, _ = RenderSQL(collection, DialectSQLServer); clause.String() != "ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 15 ROWS ONLY" {
+		t.Errorf("\n testing : RenderSQL(sqlserver) first page error : %s \n", clause.String())
+	}
+
+	// quote identifier
+	collection.Order = []*PagingOrder{{Column: "id`; DROP TABLE t; --", Direction: "asc"}}
+	if clause, _ = RenderSQL(collection, DialectMySQL); clause.OrderBy != "`id``; DROP TABLE t; --` ASC" {
+		t.Errorf("\n testing : RenderSQL quote identifier error : %s \n", clause.OrderBy)
+	}
+
+	// mixed-case column : quoted as given , without case folding
+	collection.Order = []*PagingOrder{{Column: "u.CreatedAt", Direction: "asc"}}
+	for dialect, want := range map[string]string{
+		DialectMySQL:     "`u`.`CreatedAt` ASC",
+		DialectPostgres:  `"u"."CreatedAt" ASC`,
+		DialectSQLServer: `[u].[CreatedAt] ASC`,
+		DialectOracle:    `"u"."CreatedAt" ASC`,
+	} {
+		if clause, _ = RenderSQL(collection, dialect); clause.OrderBy != want {
+			t.Errorf("\n testing : RenderSQL(%s) mixed-case column error : %s \n", dialect, clause.OrderBy)
+		}
+	}
+
+	// unknown dialect
+	if _, err = RenderSQL(collection, "db2"); err == nil {
+		t.Errorf("\n testing : RenderSQL should fail with unknown dialect \n")
+	}
+}
@@ -0,0 +1,33 @@
+package pagination
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError : invalid paging option field
+//
+// example : order_by[0].column : sort key(password) not allowed
+type ValidationError struct {
+	Field  string // paging option field (example : order_by[0].column)
+	Value  string // invalid value
+	Reason string // reason
+}
+
+// Error : error message
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s : %s", e.Field, e.Reason)
+}
+
+// ValidationErrors : invalid paging option fields
+type ValidationErrors []*ValidationError
+
+// Error : error message
+func (e ValidationErrors) Error() string {
+
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "invalid paging option : " + strings.Join(messages, " ; ")
+}